@@ -3,47 +3,400 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/2Empty/review-assigner/internal/auth"
+	"github.com/2Empty/review-assigner/internal/config"
+	"github.com/2Empty/review-assigner/internal/forge/github"
+	"github.com/2Empty/review-assigner/internal/grpcapi"
 	"github.com/2Empty/review-assigner/internal/handlers"
+	"github.com/2Empty/review-assigner/internal/health"
+	"github.com/2Empty/review-assigner/internal/logging"
+	"github.com/2Empty/review-assigner/internal/metrics"
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/notify"
 	"github.com/2Empty/review-assigner/internal/store"
+	"github.com/2Empty/review-assigner/internal/tracing"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		fatal("Failed to load config", err)
+	}
+
+	// Вывод пакета log также попадает в этот логгер
+	level, _ := cfg.Log.SlogLevel()
+	logger, err := logging.New(os.Stderr, cfg.Log.Format, level)
+	if err != nil {
+		fatal("Failed to set up logging", err)
+	}
+	slog.SetDefault(logger)
+	slog.Info("Effective configuration", "config", cfg.YAML())
 
-	time.Sleep(5 * time.Second)
+	// Контекст отменяется по SIGINT/SIGTERM и запускает остановку
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-	st, err := store.New(context.Background())
+	// Трассировка настраивается до store, чтобы запросы к базе попадали в трассы
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+		ServiceName: cfg.Tracing.ServiceName,
+	})
 	if err != nil {
-		log.Fatal("Failed to create store:", err)
+		fatal("Failed to set up tracing", err)
+	}
+
+	// Фоновые воркеры останавливаются отдельно, после дренажа запросов
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var workers sync.WaitGroup
+	workersHealth := health.NewWorkers()
+	startWorker := func(name string, run func(context.Context)) {
+		workers.Add(1)
+		workersHealth.Started(name)
+		go func() {
+			defer workers.Done()
+			defer workersHealth.Stopped(name)
+			run(workersCtx)
+			if workersCtx.Err() == nil {
+				slog.Error("Worker exited unexpectedly", "worker", name)
+			}
+		}()
 	}
-	defer st.Close()
 
-	log.Println("Successfully connected to database")
+	st, err := store.New(ctx, store.Options{
+		URL:                cfg.Database.URL,
+		MaxConns:           cfg.Database.MaxConns,
+		MinConns:           cfg.Database.MinConns,
+		MaxConnLifetime:    cfg.Database.MaxConnLifetime,
+		MaxConnIdleTime:    cfg.Database.MaxConnIdleTime,
+		HealthCheckPeriod:  cfg.Database.HealthCheckPeriod,
+		ConnectTimeout:     cfg.Database.ConnectTimeout,
+		ConnectMinBackoff:  cfg.Database.ConnectMinBackoff,
+		ConnectMaxBackoff:  cfg.Database.ConnectMaxBackoff,
+		PingInterval:       cfg.Database.PingInterval,
+		ReviewersPerPR:     cfg.Assignment.ReviewersPerPR,
+		DeactivationPolicy: cfg.Assignment.DeactivationPolicy,
+		LoginFallback:      cfg.Features.LoginFallback,
+	})
+	if err != nil {
+		fatal("Failed to create store", err)
+	}
+
+	slog.Info("Successfully connected to database")
+	startWorker("db-monitor", st.Monitor)
+
+	// Проверки готовности
+	readiness := health.NewChecker()
+	readiness.Add("database", st.Ping)
+	readiness.Add("schema", st.CheckSchema)
+	readiness.Add("workers", workersHealth.Check)
+
+	// Метрики Prometheus
+	if cfg.Features.Metrics {
+		st.Subscribe(metrics.Events{})
+		metrics.RegisterStore(st)
+	}
+
+	// Синхронизация ревьюеров с GitHub
+	if cfg.Features.GitHubSync && cfg.GitHub.Token != "" {
+		client := github.NewClient(cfg.GitHub.APIURL, cfg.GitHub.Token)
+		reviewerSync := github.NewReviewerSync(client, st, 0)
+		st.Subscribe(reviewerSync)
+		startWorker("github-sync", reviewerSync.Run)
+		slog.Info("GitHub reviewer sync enabled", "api_url", client.BaseURL)
+	}
+
+	// Уведомления в Slack
+	if cfg.Features.Notifications && cfg.Slack.URL != "" {
+		channels, err := notify.ParseMapping(cfg.Slack.TeamChannels)
+		if err != nil {
+			fatal("Invalid slack team channels", err)
+		}
+		slack, err := notify.NewSlack(notify.SlackConfig{
+			URL:            cfg.Slack.URL,
+			Token:          cfg.Slack.Token,
+			DefaultChannel: cfg.Slack.Channel,
+			Channels:       channels,
+			DirectMessages: cfg.Slack.DirectMessages,
+			Templates: notify.Templates{
+				Assigned: cfg.Slack.TemplateAssigned,
+				Replaced: cfg.Slack.TemplateReplaced,
+			},
+		}, st)
+		if err != nil {
+			fatal("Failed to create Slack notifier", err)
+		}
+		st.Subscribe(slack)
+		startWorker("slack", slack.Run)
+		slog.Info("Slack notifications enabled")
+	}
+
+	// Уведомления по почте
+	if cfg.Features.Notifications && cfg.Email.Addr != "" {
+		email, err := notify.NewEmail(notify.EmailConfig{
+			Addr:     cfg.Email.Addr,
+			Username: cfg.Email.Username,
+			Password: cfg.Email.Password,
+			From:     cfg.Email.From,
+			Subject:  cfg.Email.Subject,
+			Templates: notify.Templates{
+				Assigned: cfg.Email.TemplateAssigned,
+				Replaced: cfg.Email.TemplateReplaced,
+			},
+			DigestAt:       cfg.Email.DigestAt,
+			DigestTemplate: cfg.Email.TemplateDigest,
+		}, st)
+		if err != nil {
+			fatal("Failed to create email notifier", err)
+		}
+		st.Subscribe(email)
+		startWorker("email", email.Run)
+		slog.Info("Email notifications enabled")
+	}
+
+	// Очистка журнала событий
+	if cfg.Events.Retention > 0 {
+		startWorker("event-pruner", func(ctx context.Context) {
+			pruneEvents(ctx, st, cfg.Events.Retention)
+		})
+	}
 
 	// Инициализация ручек
 	h := handlers.NewHandler(st)
+	h.EnableReadiness(readiness)
+	if cfg.Features.Metrics {
+		h.EnableMetrics(metrics.Handler())
+	}
+	var authenticator *auth.Authenticator
+	if cfg.Features.Auth {
+		if err := checkAdminAccess(ctx, st, cfg.Auth); err != nil {
+			fatal("Authentication is enabled but no administrator can sign in", err)
+		}
+		authenticator = auth.NewAuthenticator(st, cfg.Auth.BootstrapToken)
+		if jwtCfg := cfg.Auth.JWT; jwtCfg.JWKS != "" {
+			jwks := auth.NewJWKS(jwtCfg.JWKS, jwtCfg.JWKSRefresh)
+			startWorker("jwks-refresh", jwks.Run)
+			readiness.Add("jwks", jwks.Check)
+			authenticator.EnableJWT(auth.NewJWTVerifier(jwks, auth.JWTOptions{
+				Issuer:           jwtCfg.Issuer,
+				Audience:         jwtCfg.Audience,
+				Leeway:           jwtCfg.Leeway,
+				UserClaim:        jwtCfg.UserClaim,
+				RolesClaim:       jwtCfg.RolesClaim,
+				IdentityProvider: jwtCfg.IdentityProvider,
+				Scopes:           jwtCfg.ScopeList(),
+				ClaimScopes:      jwtCfg.ClaimScopeList(),
+			}, st))
+			slog.Info("JWT authentication enabled", "issuer", jwtCfg.Issuer, "jwks", jwtCfg.JWKS)
+		}
+		h.EnableAuth(authenticator)
+		slog.Info("API token authentication enabled")
+	}
+	if cfg.Features.EventStream {
+		h.EnableEventStream(cfg.Events.Heartbeat)
+	}
+	if cfg.Features.Webhooks && cfg.GitHub.WebhookSecret != "" {
+		h.EnableGitHubWebhook(cfg.GitHub.WebhookSecret)
+	}
+	if cfg.Features.Webhooks && cfg.GitLab.WebhookToken != "" {
+		h.EnableGitLabWebhook(cfg.GitLab.WebhookToken)
+	}
 
 	// Настройка маршрутов
 	mux := http.NewServeMux()
 	h.SetupRoutes(mux)
+	var handler http.Handler = mux
+	if cfg.Features.Metrics {
+		handler = metrics.Middleware(mux)
+	}
+	handler = tracing.Middleware(handler)
+	handler = logging.Middleware(handler)
+
+	serveErr := make(chan error, 2)
+
+	// gRPC API
+	var grpcServer *grpc.Server
+	grpcHealth := grpchealth.NewServer()
+	if cfg.GRPC.Addr != "" {
+		lis, err := net.Listen("tcp", cfg.GRPC.Addr)
+		if err != nil {
+			fatal("Failed to listen for gRPC", err)
+		}
+		var opts []grpc.ServerOption
+		if authenticator != nil {
+			opts = append(opts, grpc.UnaryInterceptor(grpcapi.AuthInterceptor(authenticator)))
+		}
+		grpcServer = grpc.NewServer(opts...)
+		grpcapi.NewServer(st).Register(grpcServer)
+		healthpb.RegisterHealthServer(grpcServer, grpcHealth)
+		go func() {
+			if err := grpcServer.Serve(lis); err != nil {
+				serveErr <- fmt.Errorf("gRPC server: %w", err)
+			}
+		}()
+		slog.Info("gRPC server starting", "addr", cfg.GRPC.Addr)
+	}
 
 	// Запуск сервера
-	port := ":8080"
-	if os.Getenv("PORT") != "" {
-		port = ":" + os.Getenv("PORT")
+	srv := &http.Server{
+		Addr:              cfg.HTTP.Addr,
+		Handler:           handler,
+		ReadHeaderTimeout: cfg.HTTP.ReadHeaderTimeout,
+		ReadTimeout:       cfg.HTTP.ReadTimeout,
+		WriteTimeout:      cfg.HTTP.WriteTimeout,
+		IdleTimeout:       cfg.HTTP.IdleTimeout,
+		MaxHeaderBytes:    cfg.HTTP.MaxHeaderBytes,
 	}
+	// Shutdown не прерывает активные запросы, поэтому потоки событий закрываются явно
+	srv.RegisterOnShutdown(h.CloseEventStreams)
 
-	log.Printf("Server starting on port %s", port)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("HTTP server: %w", err)
+		}
+	}()
+	slog.Info("Server starting", "addr", cfg.HTTP.Addr)
+
+	exitCode := 0
+	select {
+	case <-ctx.Done():
+		slog.Info("Shutdown signal received")
+	case err := <-serveErr:
+		slog.Error("Server failed", "error", err)
+		exitCode = 1
+	}
+	stop()
+
+	// Сначала сервис объявляет себя неготовым, затем дренирует запросы
+	readiness.SetShuttingDown()
+	grpcHealth.Shutdown()
+	if cfg.HTTP.ShutdownDelay > 0 {
+		slog.Info("Waiting before draining requests", "delay", cfg.HTTP.ShutdownDelay)
+		time.Sleep(cfg.HTTP.ShutdownDelay)
+	}
+
+	if err := shutdown(cfg.HTTP.ShutdownTimeout, srv, grpcServer, stopWorkers, &workers, st, shutdownTracing); err != nil {
+		slog.Error("Shutdown incomplete", "error", err)
+		exitCode = 1
+	} else {
+		slog.Info("Server stopped")
+	}
+	os.Exit(exitCode)
+}
+
+// fatal записывает ошибку запуска и завершает процесс.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// shutdown останавливает сервис в пределах timeout: дренирует HTTP- и gRPC-запросы,
+// останавливает фоновые воркеры, выгружает оставшиеся спаны и только после этого
+// закрывает пул соединений. Пул закрывается в любом случае, даже если срок истёк.
+func shutdown(timeout time.Duration, srv *http.Server, grpcServer *grpc.Server,
+	stopWorkers context.CancelFunc, workers *sync.WaitGroup, st *store.Store,
+	shutdownTracing func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	defer st.Close()
+
+	var errs []error
+	if err := srv.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("drain HTTP requests: %w", err))
+	}
 
-	// Health check для Docker
+	if grpcServer != nil {
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			grpcServer.Stop()
+			errs = append(errs, fmt.Errorf("drain gRPC requests: %w", ctx.Err()))
+		}
+	}
+
+	stopWorkers()
+	done := make(chan struct{})
 	go func() {
-		time.Sleep(2 * time.Second)
-		log.Println("Application is healthy and running")
+		workers.Wait()
+		close(done)
 	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("stop workers: %w", ctx.Err()))
+	}
+
+	if err := shutdownTracing(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("flush spans: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
 
-	log.Fatal(http.ListenAndServe(port, mux))
+// checkAdminAccess проверяет, что при включённой аутентификации кто-то сможет
+// войти с областью admin и выпустить токены: через bootstrap-токен, JWT с ролью
+// admin или уже выпущенный действующий токен admin. Иначе сервис отклонял бы
+// все запросы без возможности это исправить через API.
+func checkAdminAccess(ctx context.Context, st *store.Store, cfg config.AuthConfig) error {
+	if cfg.BootstrapToken != "" || cfg.JWT.JWKS != "" {
+		return nil
+	}
+	tokens, err := st.ListAPITokens(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, t := range tokens {
+		if t.Active(now) && slices.Contains(t.Scopes, models.ScopeAdmin) {
+			return nil
+		}
+	}
+	return errors.New("set AUTH_BOOTSTRAP_TOKEN or JWT_JWKS, or disable FEATURE_AUTH")
+}
+
+// pruneEvents раз в час удаляет из журнала события старше retention.
+func pruneEvents(ctx context.Context, st *store.Store, retention time.Duration) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		n, err := st.PruneEvents(ctx, time.Now().Add(-retention))
+		if err != nil {
+			slog.Error("Failed to prune events", "error", err)
+		} else if n > 0 {
+			slog.Info("Pruned events", "count", n, "retention", retention)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
@@ -0,0 +1,427 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// app связывает клиент API и вывод.
+type app struct {
+	client *client
+	out    *printer
+}
+
+// dryRunResponse — ответ мутирующих операций в режиме dry_run.
+type dryRunResponse struct {
+	DryRun bool                `json:"dry_run"`
+	User   *models.User        `json:"user,omitempty"`
+	PR     *models.PullRequest `json:"pr,omitempty"`
+	Plan   *models.ChangePlan  `json:"plan"`
+}
+
+func (a *app) run(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	cmd, rest := args[0], args[1:]
+	if cmd == "stats" {
+		return a.stats()
+	}
+	if len(rest) == 0 {
+		return errUsage
+	}
+	sub, rest := rest[0], rest[1:]
+
+	switch cmd + " " + sub {
+	case "team add":
+		return a.teamAdd(rest)
+	case "team get":
+		return a.teamGet(rest)
+	case "team sync":
+		return a.teamSync(rest)
+	case "user activate":
+		return a.userSetActive(rest, true)
+	case "user deactivate":
+		return a.userSetActive(rest, false)
+	case "pr create":
+		return a.prCreate(rest)
+	case "pr merge":
+		return a.prMerge(rest)
+	case "pr reassign":
+		return a.prReassign(rest)
+	case "reviews list":
+		return a.reviewsList(rest)
+	}
+	return errUsage
+}
+
+func (a *app) teamAdd(args []string) error {
+	fs := flag.NewFlagSet("team add", flag.ContinueOnError)
+	file := fs.String("f", "", "файл с описанием команды (JSON или YAML, - для stdin)")
+	if err := fs.Parse(args); err != nil || *file == "" {
+		return errUsage
+	}
+
+	team, err := readTeam(*file)
+	if err != nil {
+		return err
+	}
+	var created models.Team
+	if err := a.client.post("/team/add", team, &created); err != nil {
+		return err
+	}
+	return a.out.print(created, func() *table { return teamTable(&created) })
+}
+
+// readTeam читает команду из файла. YAML является надмножеством JSON,
+// поэтому оба формата разбираются одинаково и приводятся к json-тегам моделей.
+func readTeam(path string) (*models.Team, error) {
+	data, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("parse team file: %w", err)
+	}
+	normalized, err := json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("parse team file: %w", err)
+	}
+	var team models.Team
+	if err := json.Unmarshal(normalized, &team); err != nil {
+		return nil, fmt.Errorf("parse team file: %w", err)
+	}
+	return &team, nil
+}
+
+func (a *app) teamSync(args []string) error {
+	fs := flag.NewFlagSet("team sync", flag.ContinueOnError)
+	file := fs.String("f", "", "файл с описанием команд (YAML или JSON, - для stdin)")
+	apply := fs.Bool("apply", false, "применить план; без флага изменения только показываются")
+	if err := fs.Parse(args); err != nil || *file == "" {
+		return errUsage
+	}
+
+	data, err := readFile(*file)
+	if err != nil {
+		return err
+	}
+	spec, err := models.ParseTeamSyncSpec(data)
+	if err != nil {
+		return err
+	}
+
+	path := "/team/sync?dry_run=true"
+	if *apply {
+		path = "/team/sync"
+	}
+	var plan models.SyncPlan
+	if err := a.client.post(path, spec, &plan); err != nil {
+		return err
+	}
+	return a.out.print(plan, func() *table {
+		t := &table{header: []string{"ACTION", "TEAM", "USER", "DETAIL"}}
+		for _, c := range plan.Changes {
+			detail := c.Detail
+			if c.FromTeam != "" {
+				detail = "from " + c.FromTeam
+			}
+			t.add(c.Action, c.TeamName, orDash(c.UserID), orDash(detail))
+		}
+		for _, r := range plan.Reassignments {
+			t.add("reassign", "", r.OldReviewerID, r.PullRequestID+" -> "+r.NewReviewerID)
+		}
+		for _, id := range plan.NoCandidate {
+			t.add("no_candidate", "", "-", id)
+		}
+		return t
+	})
+}
+
+// readFile читает файл или stdin, если path равен "-".
+func readFile(path string) ([]byte, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	return data, nil
+}
+
+func (a *app) teamGet(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	var team models.Team
+	if err := a.client.get("/team/get", url.Values{"team_name": {args[0]}}, &team); err != nil {
+		return err
+	}
+	return a.out.print(team, func() *table { return teamTable(&team) })
+}
+
+func teamTable(team *models.Team) *table {
+	t := &table{header: []string{"USER_ID", "USERNAME", "ROLE", "ACTIVE"}}
+	for _, m := range team.Members {
+		t.add(m.UserID, m.Username, orDash(m.Role), yesNo(m.IsActive))
+	}
+	return t
+}
+
+// userSetActive активирует или деактивирует пользователей. С флагом -team
+// используется массовая операция команды, иначе пользователи обрабатываются по одному.
+// Деактивация всей команды (-team без ID) требует явного -all.
+func (a *app) userSetActive(args []string, active bool) error {
+	name := "user deactivate"
+	if active {
+		name = "user activate"
+	}
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	team := fs.String("team", "", "команда для массовой операции")
+	rebalance := fs.Bool("rebalance", false, "передать активированным часть открытых ревью (только с -team)")
+	policy := fs.String("policy", "", "политика массовой деактивации: all_or_nothing, best_effort")
+	dryRun := fs.Bool("dry-run", false, "показать план без применения")
+	all := fs.Bool("all", false, "подтвердить деактивацию всех участников команды (-team без ID)")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	ids := fs.Args()
+
+	if *team != "" {
+		if active {
+			return a.activateTeam(*team, ids, *rebalance)
+		}
+		if len(ids) == 0 && !*all && !*dryRun {
+			return fmt.Errorf("deactivating every member of team %s requires -all", *team)
+		}
+		return a.deactivateTeam(*team, ids, *policy, *dryRun)
+	}
+	if len(ids) == 0 {
+		return errUsage
+	}
+
+	var users []models.User
+	var plans []dryRunResponse
+	for _, id := range ids {
+		req := models.SetUserActiveRequest{UserID: id, IsActive: active, DryRun: *dryRun}
+		if *dryRun {
+			var resp dryRunResponse
+			if err := a.client.post("/users/setIsActive", req, &resp); err != nil {
+				return fmt.Errorf("%s: %w", id, err)
+			}
+			plans = append(plans, resp)
+			continue
+		}
+		var user models.User
+		if err := a.client.post("/users/setIsActive", req, &user); err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		users = append(users, user)
+	}
+
+	if *dryRun {
+		return a.out.print(plans, func() *table {
+			t := planTable()
+			for _, p := range plans {
+				addPlanRows(t, p.Plan)
+			}
+			return t
+		})
+	}
+	return a.out.print(users, func() *table { return usersTable(users) })
+}
+
+func (a *app) activateTeam(team string, ids []string, rebalance bool) error {
+	var resp models.ActivateTeamUsersResponse
+	req := models.ActivateTeamUsersRequest{TeamName: team, UserIDs: ids, Rebalance: rebalance}
+	if err := a.client.post("/users/activateTeamUsers", req, &resp); err != nil {
+		return err
+	}
+	return a.out.print(resp, func() *table {
+		t := &table{header: []string{"USER_ID", "STATUS", "PR", "FROM"}}
+		if resp.ActivationReport == nil {
+			return t
+		}
+		for _, u := range resp.Users {
+			t.add(u.UserID, u.Status, "", "")
+			for _, m := range u.ReceivedReviews {
+				t.add("", "", m.PullRequestID, m.OldReviewerID)
+			}
+		}
+		return t
+	})
+}
+
+func (a *app) deactivateTeam(team string, ids []string, policy string, dryRun bool) error {
+	var resp models.DeactivateTeamUsersResponse
+	req := models.DeactivateTeamUsersRequest{TeamName: team, UserIDs: ids, Policy: policy, DryRun: dryRun}
+	if err := a.client.post("/users/deactivateTeamUsers", req, &resp); err != nil {
+		return err
+	}
+	return a.out.print(resp, func() *table {
+		t := &table{header: []string{"USER_ID", "STATUS", "PR", "OUTCOME", "REPLACED_BY"}}
+		if resp.DeactivationReport == nil {
+			return t
+		}
+		for _, u := range resp.Users {
+			t.add(u.UserID, u.Status, "", "", "")
+			for _, pr := range resp.PullRequests {
+				if pr.OldReviewerID == u.UserID {
+					t.add("", "", pr.PullRequestID, pr.Outcome, orDash(pr.ReplacedBy))
+				}
+			}
+		}
+		return t
+	})
+}
+
+func usersTable(users []models.User) *table {
+	t := &table{header: []string{"USER_ID", "USERNAME", "TEAM", "ROLE", "ACTIVE"}}
+	for _, u := range users {
+		t.add(u.UserID, u.Username, u.TeamName, orDash(u.Role), yesNo(u.IsActive))
+	}
+	return t
+}
+
+func planTable() *table {
+	return &table{header: []string{"PR", "OLD_REVIEWER", "NEW_REVIEWER"}}
+}
+
+func addPlanRows(t *table, plan *models.ChangePlan) {
+	if plan == nil {
+		return
+	}
+	for _, r := range plan.Reassignments {
+		t.add(r.PullRequestID, orDash(r.OldReviewerID), orDash(r.NewReviewerID))
+	}
+}
+
+func (a *app) prCreate(args []string) error {
+	fs := flag.NewFlagSet("pr create", flag.ContinueOnError)
+	id := fs.String("id", "", "идентификатор PR")
+	name := fs.String("name", "", "название PR")
+	author := fs.String("author", "", "автор PR")
+	dryRun := fs.Bool("dry-run", false, "показать выбранных ревьюеров без создания")
+	if err := fs.Parse(args); err != nil || *id == "" || *name == "" || *author == "" {
+		return errUsage
+	}
+
+	req := models.CreatePRRequest{PullRequestID: *id, PullRequestName: *name, AuthorID: *author, DryRun: *dryRun}
+	if *dryRun {
+		var resp dryRunResponse
+		if err := a.client.post("/pullRequest/create", req, &resp); err != nil {
+			return err
+		}
+		return a.out.print(resp, func() *table {
+			t := planTable()
+			addPlanRows(t, resp.Plan)
+			return t
+		})
+	}
+
+	var pr models.PullRequest
+	if err := a.client.post("/pullRequest/create", req, &pr); err != nil {
+		return err
+	}
+	return a.out.print(pr, func() *table { return prTable(&pr) })
+}
+
+func (a *app) prMerge(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	var pr models.PullRequest
+	if err := a.client.post("/pullRequest/merge", models.MergePRRequest{PullRequestID: args[0]}, &pr); err != nil {
+		return err
+	}
+	return a.out.print(pr, func() *table { return prTable(&pr) })
+}
+
+func (a *app) prReassign(args []string) error {
+	fs := flag.NewFlagSet("pr reassign", flag.ContinueOnError)
+	id := fs.String("id", "", "идентификатор PR")
+	old := fs.String("old", "", "заменяемый ревьюер")
+	dryRun := fs.Bool("dry-run", false, "показать замену без применения")
+	if err := fs.Parse(args); err != nil || *id == "" || *old == "" {
+		return errUsage
+	}
+
+	var resp models.ReassignReviewerResponse
+	req := models.ReassignReviewerRequest{PullRequestID: *id, OldUserID: *old, DryRun: *dryRun}
+	if err := a.client.post("/pullRequest/reassign", req, &resp); err != nil {
+		return err
+	}
+	return a.out.print(resp, func() *table {
+		t := planTable()
+		t.add(*id, *old, resp.ReplacedBy)
+		return t
+	})
+}
+
+func prTable(pr *models.PullRequest) *table {
+	t := &table{header: []string{"PR", "NAME", "AUTHOR", "STATUS", "REVIEWERS"}}
+	t.add(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, orDash(strings.Join(pr.AssignedReviewers, ",")))
+	return t
+}
+
+func (a *app) reviewsList(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	var resp models.UserReviewsResponse
+	if err := a.client.get("/users/getReview", url.Values{"user_id": {args[0]}}, &resp); err != nil {
+		return err
+	}
+	return a.out.print(resp, func() *table {
+		t := &table{header: []string{"PR", "NAME", "AUTHOR", "STATUS"}}
+		for _, pr := range resp.PullRequests {
+			t.add(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status)
+		}
+		return t
+	})
+}
+
+func (a *app) stats() error {
+	var stats models.Stats
+	if err := a.client.get("/stats", nil, &stats); err != nil {
+		return err
+	}
+	return a.out.print(stats, func() *table {
+		t := &table{header: []string{"METRIC", "VALUE"}}
+		t.add("total_prs", strconv.Itoa(stats.TotalPRs))
+		t.add("active_users", strconv.Itoa(stats.ActiveUsers))
+		t.add("total_teams", strconv.Itoa(stats.TotalTeams))
+		for _, k := range sortedKeys(stats.PRsByStatus) {
+			t.add("prs_by_status."+k, strconv.Itoa(stats.PRsByStatus[k]))
+		}
+		for _, k := range sortedKeys(stats.ReviewsByUser) {
+			t.add("reviews_by_user."+k, strconv.Itoa(stats.ReviewsByUser[k]))
+		}
+		return t
+	})
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
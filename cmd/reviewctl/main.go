@@ -0,0 +1,80 @@
+// Команда reviewctl — клиент командной строки для API сервиса назначения ревьюеров.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+)
+
+const usage = `Использование: reviewctl [флаги] <команда> <подкоманда> [аргументы]
+
+Команды:
+  team add -f FILE                         создать команду из JSON/YAML файла
+  team get NAME                            показать команду
+  team sync -f FILE [-apply]               показать план синхронизации команд из YAML и применить его
+  user activate [-team T] [-rebalance] ID...
+  user deactivate [-team T [-all]] [-policy P] [-dry-run] ID...
+                                           без ID деактивирует всю команду, что требует -all
+  pr create -id ID -name NAME -author USER [-dry-run]
+  pr merge ID
+  pr reassign -id ID -old USER [-dry-run]
+  reviews list USER
+  stats
+
+Флаги:
+`
+
+// globals — общие флаги всех команд.
+type globals struct {
+	server  string
+	token   string
+	output  string
+	timeout time.Duration
+}
+
+// errUsage сообщает о неверных аргументах команды.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	var g globals
+	fs := flag.NewFlagSet("reviewctl", flag.ExitOnError)
+	fs.StringVar(&g.server, "server", envOr("REVIEWCTL_SERVER", "http://localhost:8080"), "адрес сервиса (REVIEWCTL_SERVER)")
+	fs.StringVar(&g.token, "token", os.Getenv("REVIEWCTL_TOKEN"), "токен API (REVIEWCTL_TOKEN)")
+	fs.StringVar(&g.output, "o", envOr("REVIEWCTL_OUTPUT", formatTable), "формат вывода: table, json, yaml")
+	fs.DurationVar(&g.timeout, "timeout", 30*time.Second, "таймаут запроса")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(os.Args[1:])
+
+	switch g.output {
+	case formatTable, formatJSON, formatYAML:
+	default:
+		fmt.Fprintf(os.Stderr, "unknown output format %q\n", g.output)
+		os.Exit(2)
+	}
+
+	app := &app{
+		client: newClient(g.server, g.token, g.timeout),
+		out:    &printer{w: os.Stdout, format: g.output},
+	}
+	if err := app.run(fs.Args()); err != nil {
+		if errors.Is(err, errUsage) {
+			fs.Usage()
+			os.Exit(2)
+		}
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
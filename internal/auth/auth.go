@@ -0,0 +1,170 @@
+// Package auth проверяет API-токены и описывает вызывающую сторону запроса.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// tokenPrefix отличает токены сервиса от прочих секретов, например при поиске утечек.
+const tokenPrefix = "ra_"
+
+// BootstrapID — идентификатор вызывающей стороны для токена из конфигурации.
+const BootstrapID = "bootstrap"
+
+var (
+	// ErrUnauthenticated возвращается, если токен не передан, неизвестен, отозван или истёк.
+	ErrUnauthenticated = errors.New("unauthenticated")
+
+	// ErrForbidden возвращается, если у вызывающей стороны нет нужной области доступа.
+	ErrForbidden = errors.New("forbidden")
+)
+
+// Principal — аутентифицированная вызывающая сторона: API-токен или пользователь из JWT.
+type Principal struct {
+	ID     string
+	Name   string
+	Scopes []string
+	// UserID и Roles заполняются для пользователей, вошедших по JWT.
+	UserID string
+	Roles  []string
+}
+
+// Actor возвращает инициатора изменений для журнала событий:
+// user_id для пользователя или token:<id> для API-токена.
+func (p *Principal) Actor() string {
+	if p.UserID != "" {
+		return p.UserID
+	}
+	return "token:" + p.ID
+}
+
+// Has сообщает, разрешена ли область доступа; ScopeAdmin разрешает всё.
+func (p *Principal) Has(scope string) bool {
+	return slices.Contains(p.Scopes, models.ScopeAdmin) || slices.Contains(p.Scopes, scope)
+}
+
+// Require возвращает ErrForbidden, если область доступа не разрешена.
+func (p *Principal) Require(scope string) error {
+	if !p.Has(scope) {
+		return fmt.Errorf("%w: scope %s is required", ErrForbidden, scope)
+	}
+	return nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal сохраняет вызывающую сторону в контексте.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext возвращает вызывающую сторону из контекста или nil.
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// TokenStore — хранилище API-токенов; неизвестный хэш — store.ErrNotFound.
+type TokenStore interface {
+	GetAPITokenByHash(ctx context.Context, hash string) (*models.APIToken, error)
+	TouchAPIToken(ctx context.Context, id string) error
+}
+
+// Authenticator проверяет API-токены по хэшам в хранилище, токен начальной
+// настройки из конфигурации, которым создаются остальные токены, и, если
+// включено, JWT.
+type Authenticator struct {
+	tokens        TokenStore
+	bootstrapHash []byte
+	jwt           *JWTVerifier
+}
+
+// NewAuthenticator создает Authenticator. Пустой bootstrapToken отключает токен начальной настройки.
+func NewAuthenticator(tokens TokenStore, bootstrapToken string) *Authenticator {
+	a := &Authenticator{tokens: tokens}
+	if bootstrapToken != "" {
+		sum := sha256.Sum256([]byte(bootstrapToken))
+		a.bootstrapHash = sum[:]
+	}
+	return a
+}
+
+// EnableJWT включает проверку JWT наряду с API-токенами.
+func (a *Authenticator) EnableJWT(v *JWTVerifier) {
+	a.jwt = v
+}
+
+// Authenticate проверяет токен и возвращает вызывающую сторону.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	if token == "" {
+		return nil, fmt.Errorf("%w: token is required", ErrUnauthenticated)
+	}
+
+	if a.jwt != nil && looksLikeJWT(token) {
+		return a.jwt.Verify(ctx, token)
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	if a.bootstrapHash != nil && subtle.ConstantTimeCompare(sum[:], a.bootstrapHash) == 1 {
+		return &Principal{ID: BootstrapID, Name: BootstrapID, Scopes: []string{models.ScopeAdmin}}, nil
+	}
+
+	// Поиск идёт по хэшу, поэтому время ответа не зависит от совпадения префикса секрета
+	t, err := a.tokens.GetAPITokenByHash(ctx, hex.EncodeToString(sum[:]))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown token", ErrUnauthenticated)
+		}
+		return nil, err
+	}
+	if !t.Active(time.Now()) {
+		return nil, fmt.Errorf("%w: token is revoked or expired", ErrUnauthenticated)
+	}
+
+	if err := a.tokens.TouchAPIToken(ctx, t.ID); err != nil {
+		slog.WarnContext(ctx, "Failed to record API token use", "token_id", t.ID, "error", err)
+	}
+	return &Principal{ID: t.ID, Name: t.Name, Scopes: t.Scopes}, nil
+}
+
+// NewToken генерирует идентификатор токена, сам токен и хэш для хранения.
+func NewToken() (id, token, hash string) {
+	id = hex.EncodeToString(randomBytes(8))
+	token = tokenPrefix + id + "_" + hex.EncodeToString(randomBytes(32))
+	return id, token, HashToken(token)
+}
+
+// HashToken возвращает хэш токена в том виде, в котором он хранится в базе.
+// Токен содержит 256 бит случайных данных, поэтому медленный хэш не нужен.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// BearerToken извлекает токен из заголовка Authorization: Bearer <token>.
+func BearerToken(header string) string {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	rand.Read(b)
+	return b
+}
@@ -0,0 +1,263 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Ограничения загрузки JWKS.
+const (
+	jwksFetchTimeout = 10 * time.Second
+	jwksMaxSize      = 1 << 20
+	// jwksMinRefresh — не чаще этого интервала ключи перечитываются из-за неизвестного kid.
+	jwksMinRefresh = time.Minute
+)
+
+// JWKS — набор открытых ключей для проверки подписи JWT, загружаемый из файла
+// или по URL (http:// или https://) и периодически обновляемый.
+type JWKS struct {
+	source  string
+	refresh time.Duration
+	client  *http.Client
+
+	mu       sync.RWMutex
+	keys     map[string]any
+	loadedAt time.Time
+	loadErr  error
+}
+
+// NewJWKS создает набор ключей; ключи загружаются вызовом Load или Run.
+func NewJWKS(source string, refresh time.Duration) *JWKS {
+	return &JWKS{
+		source:  source,
+		refresh: refresh,
+		client:  &http.Client{Timeout: jwksFetchTimeout},
+	}
+}
+
+// Load загружает ключи. При ошибке ранее загруженные ключи сохраняются.
+func (k *JWKS) Load(ctx context.Context) error {
+	keys, err := k.fetch(ctx)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.loadedAt = time.Now()
+	k.loadErr = err
+	if err != nil {
+		return err
+	}
+	k.keys = keys
+	return nil
+}
+
+// Run перечитывает ключи с периодом refresh до отмены контекста.
+func (k *JWKS) Run(ctx context.Context) {
+	if err := k.Load(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to load JWKS", "source", k.source, "error", err)
+	}
+
+	ticker := time.NewTicker(k.refresh)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := k.Load(ctx); err != nil {
+			slog.WarnContext(ctx, "Failed to refresh JWKS, keeping previous keys", "source", k.source, "error", err)
+		}
+	}
+}
+
+// Check возвращает ошибку, пока ни одного ключа не загружено.
+func (k *JWKS) Check(context.Context) error {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if len(k.keys) == 0 {
+		if k.loadErr != nil {
+			return fmt.Errorf("no JWKS keys loaded: %w", k.loadErr)
+		}
+		return errors.New("no JWKS keys loaded")
+	}
+	return nil
+}
+
+// Key возвращает ключ по kid. Если ключ неизвестен, набор перечитывается,
+// но не чаще jwksMinRefresh, чтобы поддельные kid не нагружали источник.
+// Токен без kid принимается, только если в наборе ровно один ключ.
+func (k *JWKS) Key(ctx context.Context, kid string) (any, error) {
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	k.mu.RLock()
+	stale := time.Since(k.loadedAt) >= jwksMinRefresh
+	k.mu.RUnlock()
+	if stale {
+		if err := k.Load(ctx); err != nil {
+			slog.WarnContext(ctx, "Failed to refresh JWKS for unknown key", "kid", kid, "error", err)
+		}
+		if key, ok := k.lookup(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (k *JWKS) lookup(kid string) (any, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+func (k *JWKS) fetch(ctx context.Context) (map[string]any, error) {
+	var data []byte
+	if strings.HasPrefix(k.source, "http://") || strings.HasPrefix(k.source, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.source, nil)
+		if err != nil {
+			return nil, fmt.Errorf("create JWKS request: %w", err)
+		}
+		resp, err := k.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("fetch JWKS: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetch JWKS: unexpected status %s", resp.Status)
+		}
+		data, err = io.ReadAll(io.LimitReader(resp.Body, jwksMaxSize))
+		if err != nil {
+			return nil, fmt.Errorf("read JWKS: %w", err)
+		}
+	} else {
+		var err error
+		data, err = os.ReadFile(k.source)
+		if err != nil {
+			return nil, fmt.Errorf("read JWKS file: %w", err)
+		}
+	}
+	return parseJWKS(data)
+}
+
+// jwk — открытый ключ в формате RFC 7517.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS разбирает набор ключей. Ключи шифрования и неподдерживаемых типов
+// пропускаются; симметричные ключи не принимаются вовсе.
+func parseJWKS(data []byte) (map[string]any, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parse JWKS: %w", err)
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for _, j := range set.Keys {
+		if j.Use != "" && j.Use != "sig" {
+			continue
+		}
+		key, err := j.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("parse JWKS key %q: %w", j.Kid, err)
+		}
+		if key != nil {
+			keys[j.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS contains no signing keys")
+	}
+	return keys, nil
+}
+
+func (j jwk) publicKey() (any, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBigInt(j.N)
+		if err != nil {
+			return nil, fmt.Errorf("modulus: %w", err)
+		}
+		e, err := decodeBigInt(j.E)
+		if err != nil {
+			return nil, fmt.Errorf("exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() < 3 || n.BitLen() < 2048 {
+			return nil, errors.New("RSA key is too weak")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		x, errX := base64.RawURLEncoding.DecodeString(j.X)
+		y, errY := base64.RawURLEncoding.DecodeString(j.Y)
+		if errX != nil || errY != nil || len(x) != size || len(y) != size {
+			return nil, errors.New("invalid EC coordinates")
+		}
+		// Разбор несжатой точки заодно проверяет, что она лежит на кривой
+		key, err := ecdsa.ParseUncompressedPublicKey(curve, append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, errors.New("invalid base64url value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// RoleAdmin — роль в JWT, дающая область admin.
+const RoleAdmin = "admin"
+
+// signingMethods — допустимые алгоритмы подписи. Симметричные алгоритмы и none
+// исключены, чтобы открытый ключ нельзя было использовать как секрет HMAC.
+var signingMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// IdentityLookup находит пользователя по внешней учётной записи.
+type IdentityLookup interface {
+	LookupIdentity(ctx context.Context, provider, externalID string) (*models.Identity, error)
+}
+
+// JWTOptions задаёт проверку JWT и сопоставление claims пользователю.
+type JWTOptions struct {
+	Issuer   string
+	Audience string
+	// Leeway — допуск расхождения часов при проверке exp, nbf и iat.
+	Leeway time.Duration
+	// UserClaim — claim с идентификатором пользователя, по умолчанию sub.
+	UserClaim string
+	// RolesClaim — claim со списком ролей, по умолчанию roles. Вложенные claims
+	// задаются через точку, например realm_access.roles.
+	RolesClaim string
+	// IdentityProvider, если задан, означает, что UserClaim содержит внешнюю учётную
+	// запись этого провайдера (например, email), а не user_id сервиса.
+	IdentityProvider string
+	// Scopes — области доступа всех пользователей с JWT. Роль admin даёт область admin.
+	Scopes []string
+	// ClaimScopes — области, которые токен может добавить к Scopes через claim scope.
+	// Область admin из claim не принимается никогда: её даёт только роль admin.
+	ClaimScopes []string
+}
+
+// JWTVerifier проверяет JWT по ключам JWKS.
+type JWTVerifier struct {
+	keys       *JWKS
+	opts       JWTOptions
+	identities IdentityLookup
+	parser     *jwt.Parser
+}
+
+// NewJWTVerifier создает JWTVerifier. identities нужен, только если задан IdentityProvider.
+func NewJWTVerifier(keys *JWKS, opts JWTOptions, identities IdentityLookup) *JWTVerifier {
+	if opts.UserClaim == "" {
+		opts.UserClaim = "sub"
+	}
+	if opts.RolesClaim == "" {
+		opts.RolesClaim = "roles"
+	}
+	return &JWTVerifier{
+		keys:       keys,
+		opts:       opts,
+		identities: identities,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods(signingMethods),
+			jwt.WithIssuer(opts.Issuer),
+			jwt.WithAudience(opts.Audience),
+			jwt.WithExpirationRequired(),
+			jwt.WithIssuedAt(),
+			jwt.WithLeeway(opts.Leeway),
+		),
+	}
+}
+
+// Verify проверяет подпись, issuer, audience и срок действия токена
+// и возвращает пользователя с его ролями.
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (*Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.keys.Key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid JWT: %v", ErrUnauthenticated, err)
+	}
+
+	subject, _ := claim(claims, v.opts.UserClaim).(string)
+	if subject == "" {
+		return nil, fmt.Errorf("%w: claim %s is required", ErrUnauthenticated, v.opts.UserClaim)
+	}
+	userID := subject
+	if v.opts.IdentityProvider != "" {
+		identity, err := v.identities.LookupIdentity(ctx, v.opts.IdentityProvider, subject)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return nil, fmt.Errorf("%w: no user linked to %s %s", ErrUnauthenticated, v.opts.IdentityProvider, subject)
+			}
+			return nil, err
+		}
+		userID = identity.UserID
+	}
+
+	roles := stringList(claim(claims, v.opts.RolesClaim))
+	scopes := slices.Clone(v.opts.Scopes)
+	if slices.Contains(roles, RoleAdmin) {
+		scopes = append(scopes, models.ScopeAdmin)
+	}
+	if s, ok := claims["scope"].(string); ok {
+		for _, scope := range strings.Fields(s) {
+			if scope != models.ScopeAdmin && slices.Contains(v.opts.ClaimScopes, scope) && !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	return &Principal{
+		ID:     userID,
+		Name:   subject,
+		UserID: userID,
+		Roles:  roles,
+		Scopes: scopes,
+	}, nil
+}
+
+// claim возвращает значение claim по пути через точку.
+func claim(claims jwt.MapClaims, path string) any {
+	var v any = map[string]any(claims)
+	for _, part := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[part]
+	}
+	return v
+}
+
+// stringList приводит claim к списку строк: массив строк или одна строка через пробел.
+func stringList(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return strings.Fields(v)
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
+
+// looksLikeJWT отличает JWT (три части через точку) от API-токена.
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
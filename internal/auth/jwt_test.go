@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "review-assigner"
+	testKid      = "key-1"
+)
+
+// newTestVerifier создаёт JWTVerifier с одним RSA-ключом из временного файла JWKS.
+func newTestVerifier(t *testing.T) (*JWTVerifier, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	set := map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": testKid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("encode JWKS: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write JWKS: %v", err)
+	}
+
+	keys := NewJWKS(path, time.Hour)
+	if err := keys.Load(context.Background()); err != nil {
+		t.Fatalf("load JWKS: %v", err)
+	}
+	return NewJWTVerifier(keys, JWTOptions{
+		Issuer:      testIssuer,
+		Audience:    testAudience,
+		Scopes:      []string{models.ScopeRead},
+		ClaimScopes: []string{models.ScopeStats},
+	}, nil), key
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func TestVerify(t *testing.T) {
+	v, key := newTestVerifier(t)
+	now := time.Now()
+	claims := func(extra jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"sub": "u1",
+			"iss": testIssuer,
+			"aud": testAudience,
+			"iat": now.Unix(),
+			"exp": now.Add(time.Hour).Unix(),
+		}
+		for k, val := range extra {
+			c[k] = val
+		}
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		kid        string
+		claims     jwt.MapClaims
+		wantErr    bool
+		wantScopes []string
+	}{
+		{"base scopes", testKid, claims(nil), false, []string{models.ScopeRead}},
+		{"admin role", testKid, claims(jwt.MapClaims{"roles": []any{"admin"}}), false, []string{models.ScopeRead, models.ScopeAdmin}},
+		{"allowed claim scope", testKid, claims(jwt.MapClaims{"scope": "stats"}), false, []string{models.ScopeRead, models.ScopeStats}},
+		{"admin claim scope ignored", testKid, claims(jwt.MapClaims{"scope": "admin"}), false, []string{models.ScopeRead}},
+		{"claim scope outside allow-list ignored", testKid, claims(jwt.MapClaims{"scope": "team:admin pr:write"}), false, []string{models.ScopeRead}},
+		{"expired", testKid, claims(jwt.MapClaims{"exp": now.Add(-time.Hour).Unix()}), true, nil},
+		{"missing exp", testKid, claims(jwt.MapClaims{"exp": nil}), true, nil},
+		{"wrong issuer", testKid, claims(jwt.MapClaims{"iss": "https://evil.example.com"}), true, nil},
+		{"wrong audience", testKid, claims(jwt.MapClaims{"aud": "other"}), true, nil},
+		{"unknown kid", "key-2", claims(nil), true, nil},
+		{"missing subject", testKid, claims(jwt.MapClaims{"sub": ""}), true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.claims
+			for k, val := range c {
+				if val == nil {
+					delete(c, k)
+				}
+			}
+			p, err := v.Verify(context.Background(), signToken(t, key, tt.kid, c))
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnauthenticated) {
+					t.Fatalf("Verify() error = %v, want ErrUnauthenticated", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if p.UserID != "u1" {
+				t.Errorf("UserID = %s, want u1", p.UserID)
+			}
+			if !slices.Equal(p.Scopes, tt.wantScopes) {
+				t.Errorf("Scopes = %v, want %v", p.Scopes, tt.wantScopes)
+			}
+		})
+	}
+}
+
+func TestVerifyWrongKey(t *testing.T) {
+	v, _ := newTestVerifier(t)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	token := signToken(t, other, testKid, jwt.MapClaims{
+		"sub": "u1", "iss": testIssuer, "aud": testAudience,
+		"iat": time.Now().Unix(), "exp": time.Now().Add(time.Hour).Unix(),
+	})
+	if _, err := v.Verify(context.Background(), token); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("Verify() error = %v, want ErrUnauthenticated", err)
+	}
+}
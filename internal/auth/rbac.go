@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// UserDirectory находит пользователя сервиса и команду PR; неизвестный
+// пользователь или PR — store.ErrNotFound.
+type UserDirectory interface {
+	GetUser(ctx context.Context, userID string) (*models.UserDetails, error)
+	GetPRTeam(ctx context.Context, prID string) (string, error)
+}
+
+// Authorizer проверяет права на ресурсы команды. Администратор (область admin)
+// управляет всеми командами. Пользователь из JWT управляет только командой,
+// в которой он записан с ролью lead. API-токены не привязаны к пользователю
+// и ограничиваются только областями доступа.
+type Authorizer struct {
+	users UserDirectory
+}
+
+// NewAuthorizer создает Authorizer.
+func NewAuthorizer(users UserDirectory) *Authorizer {
+	return &Authorizer{users: users}
+}
+
+// RequireTeam — вариант Require для действий над командой: пользователь из JWT
+// проходит и без области scope, его права на конкретную команду затем
+// проверяет Authorizer.
+func (p *Principal) RequireTeam(scope string) error {
+	if p.UserID != "" {
+		return nil
+	}
+	return p.Require(scope)
+}
+
+// AuthorizeTeam возвращает ErrForbidden, если вызывающая сторона не может
+// управлять участниками и PR команды team. Без вызывающей стороны
+// (аутентификация выключена) всё разрешено.
+func (a *Authorizer) AuthorizeTeam(ctx context.Context, p *Principal, team string) error {
+	if p == nil || p.Has(models.ScopeAdmin) || p.UserID == "" {
+		return nil
+	}
+
+	caller, err := a.users.GetUser(ctx, p.UserID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("%w: user %s is not registered", ErrForbidden, p.UserID)
+		}
+		return fmt.Errorf("get caller: %w", err)
+	}
+	if caller.Role != models.RoleLead || caller.TeamName != team {
+		return fmt.Errorf("%w: only a lead of team %s or an admin may manage it", ErrForbidden, team)
+	}
+	return nil
+}
+
+// AuthorizeUser проверяет права на участника userID: нужны права на его команду.
+// Неизвестный пользователь для не-администратора — ErrForbidden, чтобы отказ
+// не раскрывал, существует ли пользователь.
+func (a *Authorizer) AuthorizeUser(ctx context.Context, p *Principal, userID string) error {
+	if p == nil || p.Has(models.ScopeAdmin) || p.UserID == "" {
+		return nil
+	}
+
+	user, err := a.users.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("%w: user %s is not in a team managed by the caller", ErrForbidden, userID)
+		}
+		return fmt.Errorf("get user: %w", err)
+	}
+	return a.AuthorizeTeam(ctx, p, user.TeamName)
+}
+
+// AuthorizePR проверяет права на PR prID: нужны права на команду его автора.
+// Неизвестный PR для не-администратора — ErrForbidden, как и в AuthorizeUser.
+func (a *Authorizer) AuthorizePR(ctx context.Context, p *Principal, prID string) error {
+	if p == nil || p.Has(models.ScopeAdmin) || p.UserID == "" {
+		return nil
+	}
+
+	team, err := a.users.GetPRTeam(ctx, prID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("%w: PR %s is not in a team managed by the caller", ErrForbidden, prID)
+		}
+		return fmt.Errorf("get PR team: %w", err)
+	}
+	return a.AuthorizeTeam(ctx, p, team)
+}
@@ -0,0 +1,368 @@
+// Package config описывает конфигурацию сервера и её загрузку
+// из значений по умолчанию, YAML-файла, переменных окружения и флагов.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+)
+
+// redacted заменяет значения секретов при выводе конфигурации.
+const redacted = "***"
+
+// Config — полная конфигурация сервера.
+type Config struct {
+	HTTP       HTTPConfig       `yaml:"http"`
+	GRPC       GRPCConfig       `yaml:"grpc"`
+	Database   DatabaseConfig   `yaml:"database"`
+	Log        LogConfig        `yaml:"log"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	Auth       AuthConfig       `yaml:"auth"`
+	Features   FeaturesConfig   `yaml:"features"`
+	Assignment AssignmentConfig `yaml:"assignment"`
+	Events     EventsConfig     `yaml:"events"`
+	GitHub     GitHubConfig     `yaml:"github"`
+	GitLab     GitLabConfig     `yaml:"gitlab"`
+	Slack      SlackConfig      `yaml:"slack"`
+	Email      EmailConfig      `yaml:"email"`
+}
+
+// HTTPConfig — параметры HTTP-сервера.
+type HTTPConfig struct {
+	Addr              string        `yaml:"addr"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	MaxHeaderBytes    int           `yaml:"max_header_bytes"`
+	// ShutdownDelay — пауза между переходом в «не готов» и дренажем запросов,
+	// за которую балансировщик успевает исключить экземпляр.
+	ShutdownDelay time.Duration `yaml:"shutdown_delay"`
+	// ShutdownTimeout ограничивает всю остановку: дренаж запросов, воркеры и закрытие пула.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// GRPCConfig — параметры gRPC-сервера; пустой адрес отключает его.
+type GRPCConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+// DatabaseConfig — подключение к PostgreSQL и параметры пула pgx.
+type DatabaseConfig struct {
+	URL               string        `yaml:"url"`
+	MaxConns          int32         `yaml:"max_conns"`
+	MinConns          int32         `yaml:"min_conns"`
+	MaxConnLifetime   time.Duration `yaml:"max_conn_lifetime"`
+	MaxConnIdleTime   time.Duration `yaml:"max_conn_idle_time"`
+	HealthCheckPeriod time.Duration `yaml:"health_check_period"`
+	ConnectTimeout    time.Duration `yaml:"connect_timeout"`
+	ConnectMinBackoff time.Duration `yaml:"connect_min_backoff"`
+	ConnectMaxBackoff time.Duration `yaml:"connect_max_backoff"`
+	PingInterval      time.Duration `yaml:"ping_interval"`
+}
+
+// LogConfig — параметры журналирования.
+type LogConfig struct {
+	Level  string `yaml:"level"`  // debug, info, warn, error
+	Format string `yaml:"format"` // json, text
+}
+
+// TracingConfig — экспорт трасс OpenTelemetry.
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter"` // none, otlp, stdout
+	Endpoint    string  `yaml:"endpoint"` // OTLP/gRPC коллектор host:port
+	Insecure    bool    `yaml:"insecure"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+	ServiceName string  `yaml:"service_name"`
+}
+
+// AuthConfig — аутентификация по API-токенам и JWT.
+type AuthConfig struct {
+	// BootstrapToken даёт область admin и нужен для выпуска первых токенов.
+	BootstrapToken string    `yaml:"bootstrap_token"`
+	JWT            JWTConfig `yaml:"jwt"`
+}
+
+// JWTConfig — проверка JWT; пустой JWKS отключает её.
+type JWTConfig struct {
+	JWKS             string        `yaml:"jwks"` // путь к файлу или URL
+	JWKSRefresh      time.Duration `yaml:"jwks_refresh"`
+	Issuer           string        `yaml:"issuer"`
+	Audience         string        `yaml:"audience"`
+	Leeway           time.Duration `yaml:"leeway"`
+	UserClaim        string        `yaml:"user_claim"`
+	RolesClaim       string        `yaml:"roles_claim"`
+	IdentityProvider string        `yaml:"identity_provider"` // пусто — user_claim содержит user_id
+	Scopes           string        `yaml:"scopes"`            // "read,pr:write"
+	// ClaimScopes — области, которые JWT может добавить себе через claim scope;
+	// пусто — claim scope не учитывается. Область admin даёт только роль admin.
+	ClaimScopes string `yaml:"claim_scopes"`
+}
+
+// ScopeList возвращает области доступа пользователей с JWT.
+func (c JWTConfig) ScopeList() []string {
+	return splitScopes(c.Scopes)
+}
+
+// ClaimScopeList возвращает области, допустимые в claim scope.
+func (c JWTConfig) ClaimScopeList() []string {
+	return splitScopes(c.ClaimScopes)
+}
+
+func splitScopes(list string) []string {
+	var scopes []string
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// FeaturesConfig — переключатели функций. Интеграции дополнительно
+// требуют заданных учётных данных в своих разделах.
+type FeaturesConfig struct {
+	EventStream   bool `yaml:"event_stream"`
+	Webhooks      bool `yaml:"webhooks"`
+	GitHubSync    bool `yaml:"github_sync"`
+	Notifications bool `yaml:"notifications"`
+	Metrics       bool `yaml:"metrics"`
+	Auth          bool `yaml:"auth"`
+	// LoginFallback сопоставляет логин без привязки в user_identities с user_id или
+	// username: для авторов вебхуков и для ревьюеров, синхронизируемых в GitHub.
+	// Выключено: совпадение имён не доказывает, что это один человек.
+	LoginFallback bool `yaml:"login_fallback"`
+}
+
+// AssignmentConfig — параметры назначения ревьюеров по умолчанию.
+type AssignmentConfig struct {
+	ReviewersPerPR     int    `yaml:"reviewers_per_pr"`
+	DeactivationPolicy string `yaml:"deactivation_policy"`
+}
+
+// EventsConfig — параметры журнала и потока событий.
+type EventsConfig struct {
+	Retention time.Duration `yaml:"retention"` // 0 — хранить бессрочно
+	Heartbeat time.Duration `yaml:"heartbeat"`
+}
+
+// GitHubConfig — интеграция с GitHub.
+type GitHubConfig struct {
+	Token         string `yaml:"token"`
+	APIURL        string `yaml:"api_url"`
+	WebhookSecret string `yaml:"webhook_secret"`
+}
+
+// GitLabConfig — интеграция с GitLab.
+type GitLabConfig struct {
+	WebhookToken string `yaml:"webhook_token"`
+}
+
+// SlackConfig — уведомления в Slack.
+type SlackConfig struct {
+	URL              string `yaml:"url"`
+	Token            string `yaml:"token"`
+	Channel          string `yaml:"channel"`
+	TeamChannels     string `yaml:"team_channels"` // "team=channel,..."
+	DirectMessages   bool   `yaml:"direct_messages"`
+	TemplateAssigned string `yaml:"template_assigned"`
+	TemplateReplaced string `yaml:"template_replaced"`
+}
+
+// EmailConfig — уведомления по почте.
+type EmailConfig struct {
+	Addr             string `yaml:"addr"`
+	Username         string `yaml:"username"`
+	Password         string `yaml:"password"`
+	From             string `yaml:"from"`
+	Subject          string `yaml:"subject"`
+	TemplateAssigned string `yaml:"template_assigned"`
+	TemplateReplaced string `yaml:"template_replaced"`
+	TemplateDigest   string `yaml:"template_digest"`
+	DigestAt         string `yaml:"digest_at"` // HH:MM, пусто — без дайджеста
+}
+
+// Default возвращает конфигурацию со значениями по умолчанию.
+func Default() *Config {
+	return &Config{
+		HTTP: HTTPConfig{
+			Addr:              ":8080",
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       15 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			MaxHeaderBytes:    1 << 20,
+			ShutdownTimeout:   20 * time.Second,
+		},
+		Database: DatabaseConfig{
+			MaxConns:          10,
+			MaxConnLifetime:   time.Hour,
+			MaxConnIdleTime:   30 * time.Minute,
+			HealthCheckPeriod: time.Minute,
+			ConnectTimeout:    time.Minute,
+			ConnectMinBackoff: 500 * time.Millisecond,
+			ConnectMaxBackoff: 10 * time.Second,
+			PingInterval:      5 * time.Second,
+		},
+		Log: LogConfig{Level: "info", Format: "json"},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			Endpoint:    "localhost:4317",
+			SampleRatio: 1,
+			ServiceName: "review-assigner",
+		},
+		Auth: AuthConfig{
+			JWT: JWTConfig{
+				JWKSRefresh: 15 * time.Minute,
+				Leeway:      30 * time.Second,
+				UserClaim:   "sub",
+				RolesClaim:  "roles",
+				Scopes:      "read,pr:write",
+			},
+		},
+		Features: FeaturesConfig{
+			EventStream:   true,
+			Webhooks:      true,
+			GitHubSync:    true,
+			Notifications: true,
+			Metrics:       true,
+			Auth:          true,
+		},
+		Assignment: AssignmentConfig{
+			ReviewersPerPR:     2,
+			DeactivationPolicy: models.PolicyAllOrNothing,
+		},
+		Events: EventsConfig{Heartbeat: 15 * time.Second},
+	}
+}
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки сразу.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.HTTP.Addr != "", "http.addr is required")
+	check(c.HTTP.ReadHeaderTimeout > 0, "http.read_header_timeout must be positive")
+	check(c.HTTP.ReadTimeout > 0, "http.read_timeout must be positive")
+	check(c.HTTP.WriteTimeout > 0, "http.write_timeout must be positive")
+	check(c.HTTP.IdleTimeout > 0, "http.idle_timeout must be positive")
+	check(c.HTTP.MaxHeaderBytes >= 4<<10, "http.max_header_bytes must be at least 4096")
+	check(c.HTTP.ShutdownDelay >= 0, "http.shutdown_delay must not be negative")
+	check(c.HTTP.ShutdownTimeout > 0, "http.shutdown_timeout must be positive")
+
+	check(c.Database.URL != "", "database.url is required")
+	check(c.Database.MaxConns > 0, "database.max_conns must be positive")
+	check(c.Database.MinConns >= 0 && c.Database.MinConns <= c.Database.MaxConns,
+		"database.min_conns must be between 0 and database.max_conns")
+	check(c.Database.MaxConnLifetime > 0, "database.max_conn_lifetime must be positive")
+	check(c.Database.MaxConnIdleTime > 0, "database.max_conn_idle_time must be positive")
+	check(c.Database.HealthCheckPeriod > 0, "database.health_check_period must be positive")
+	check(c.Database.ConnectTimeout > 0, "database.connect_timeout must be positive")
+	check(c.Database.ConnectMinBackoff > 0 && c.Database.ConnectMinBackoff <= c.Database.ConnectMaxBackoff,
+		"database.connect_min_backoff must be positive and not exceed database.connect_max_backoff")
+	check(c.Database.PingInterval > 0, "database.ping_interval must be positive")
+
+	_, err := c.Log.SlogLevel()
+	check(err == nil, "log.level must be one of debug, info, warn, error")
+	check(c.Log.Format == "json" || c.Log.Format == "text", "log.format must be json or text")
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		check(c.Tracing.Endpoint != "", "tracing.endpoint is required for the otlp exporter")
+	default:
+		check(false, "tracing.exporter must be one of none, otlp, stdout")
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio must be between 0 and 1")
+	check(c.Tracing.ServiceName != "", "tracing.service_name is required")
+	check(c.Auth.BootstrapToken == "" || len(c.Auth.BootstrapToken) >= 32,
+		"auth.bootstrap_token must be at least 32 characters")
+	if jwt := c.Auth.JWT; jwt.JWKS != "" {
+		check(jwt.Issuer != "", "auth.jwt.issuer is required when auth.jwt.jwks is set")
+		check(jwt.Audience != "", "auth.jwt.audience is required when auth.jwt.jwks is set")
+		check(jwt.JWKSRefresh > 0, "auth.jwt.jwks_refresh must be positive")
+		check(jwt.Leeway >= 0, "auth.jwt.leeway must not be negative")
+		check(jwt.UserClaim != "", "auth.jwt.user_claim is required")
+		check(jwt.IdentityProvider == "" || models.IsValidProvider(jwt.IdentityProvider),
+			"auth.jwt.identity_provider must be one of github, gitlab, slack, email")
+		for _, s := range jwt.ScopeList() {
+			check(models.IsValidScope(s), "auth.jwt.scopes: unknown scope %q", s)
+		}
+		for _, s := range jwt.ClaimScopeList() {
+			check(models.IsValidScope(s), "auth.jwt.claim_scopes: unknown scope %q", s)
+			check(s != models.ScopeAdmin, "auth.jwt.claim_scopes must not include admin: it is granted only by the admin role")
+		}
+	}
+
+	check(c.Assignment.ReviewersPerPR >= 1 && c.Assignment.ReviewersPerPR <= 10,
+		"assignment.reviewers_per_pr must be between 1 and 10")
+	check(c.Assignment.DeactivationPolicy == models.PolicyAllOrNothing || c.Assignment.DeactivationPolicy == models.PolicyBestEffort,
+		"assignment.deactivation_policy must be all_or_nothing or best_effort")
+
+	check(c.Events.Retention >= 0, "events.retention must not be negative")
+	check(c.Events.Heartbeat > 0, "events.heartbeat must be positive")
+
+	// Incoming webhook публикует только в свой канал, личные сообщения требуют chat.postMessage
+	check(c.Slack.URL == "" || !c.Slack.DirectMessages || c.Slack.Token != "", "slack.token is required for slack.direct_messages")
+
+	if c.Email.DigestAt != "" {
+		_, err := time.Parse("15:04", c.Email.DigestAt)
+		check(err == nil, "email.digest_at must be in HH:MM format")
+	}
+	if c.Email.Addr != "" {
+		check(c.Email.From != "", "email.from is required when email.addr is set")
+	}
+
+	return errors.Join(errs...)
+}
+
+// SlogLevel разбирает уровень журналирования.
+func (l LogConfig) SlogLevel() (slog.Level, error) {
+	var level slog.Level
+	err := level.UnmarshalText([]byte(strings.ToLower(l.Level)))
+	return level, err
+}
+
+// Redacted возвращает копию конфигурации, в которой секреты скрыты.
+// В URL скрывается только пароль, чтобы адрес оставался узнаваемым.
+func (c *Config) Redacted() *Config {
+	r := *c
+	r.Database.URL = redactURL(r.Database.URL)
+	r.Auth.BootstrapToken = redact(r.Auth.BootstrapToken)
+	r.GitHub.Token = redact(r.GitHub.Token)
+	r.GitHub.WebhookSecret = redact(r.GitHub.WebhookSecret)
+	r.GitLab.WebhookToken = redact(r.GitLab.WebhookToken)
+	// URL входящего вебхука Slack сам является секретом
+	r.Slack.URL = redact(r.Slack.URL)
+	r.Slack.Token = redact(r.Slack.Token)
+	r.Email.Password = redact(r.Email.Password)
+	return &r
+}
+
+func redact(s string) string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func redactURL(s string) string {
+	if s == "" {
+		return ""
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return redact(s)
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,234 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// binding связывает поле конфигурации с флагом и переменной окружения.
+type binding struct {
+	flag string
+	env  string
+}
+
+// Load собирает конфигурацию по возрастанию приоритета: значения по умолчанию,
+// YAML-файл (флаг -config или CONFIG_FILE), переменные окружения, флаги.
+// Результат проверяется через Validate.
+func Load(args []string) (*Config, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("review-assigner", flag.ContinueOnError)
+	path := fs.String("config", os.Getenv("CONFIG_FILE"), "путь к YAML-файлу конфигурации (env CONFIG_FILE)")
+	bindings := cfg.bind(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	// Флаги уже записаны в cfg; запоминаем их и собираем конфигурацию заново,
+	// чтобы флаги применились последними.
+	explicit := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = f.Value.String()
+	})
+	*cfg = *Default()
+
+	if *path != "" {
+		if err := cfg.loadFile(*path); err != nil {
+			return nil, err
+		}
+	}
+
+	// PORT поддерживается для совместимости с прежним способом запуска
+	if port := os.Getenv("PORT"); port != "" {
+		cfg.HTTP.Addr = ":" + port
+	}
+	for _, b := range bindings {
+		if v := os.Getenv(b.env); v != "" {
+			if err := fs.Set(b.flag, v); err != nil {
+				return nil, fmt.Errorf("env %s: %w", b.env, err)
+			}
+		}
+	}
+
+	for name, v := range explicit {
+		if name == "config" {
+			continue
+		}
+		if err := fs.Set(name, v); err != nil {
+			return nil, fmt.Errorf("flag -%s: %w", name, err)
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}
+
+// loadFile накладывает на конфигурацию значения из YAML-файла.
+// Неизвестные ключи считаются ошибкой, чтобы опечатки не проходили незамеченными.
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// YAML возвращает конфигурацию со скрытыми секретами в формате YAML.
+func (c *Config) YAML() string {
+	data, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return fmt.Sprintf("<failed to encode config: %v>", err)
+	}
+	return string(data)
+}
+
+// bind регистрирует флаги, записывающие значения прямо в поля конфигурации,
+// и возвращает соответствие флагов переменным окружения.
+func (c *Config) bind(fs *flag.FlagSet) []binding {
+	var bindings []binding
+	str := func(p *string, name, env, usage string) {
+		fs.StringVar(p, name, *p, usage+" (env "+env+")")
+		bindings = append(bindings, binding{flag: name, env: env})
+	}
+	boolean := func(p *bool, name, env, usage string) {
+		fs.BoolVar(p, name, *p, usage+" (env "+env+")")
+		bindings = append(bindings, binding{flag: name, env: env})
+	}
+	integer := func(p *int, name, env, usage string) {
+		fs.IntVar(p, name, *p, usage+" (env "+env+")")
+		bindings = append(bindings, binding{flag: name, env: env})
+	}
+	duration := func(p *time.Duration, name, env, usage string) {
+		fs.DurationVar(p, name, *p, usage+" (env "+env+")")
+		bindings = append(bindings, binding{flag: name, env: env})
+	}
+	float := func(p *float64, name, env, usage string) {
+		fs.Float64Var(p, name, *p, usage+" (env "+env+")")
+		bindings = append(bindings, binding{flag: name, env: env})
+	}
+	conns := func(p *int32, name, env, usage string) {
+		fs.Var((*int32Value)(p), name, usage+" (env "+env+")")
+		bindings = append(bindings, binding{flag: name, env: env})
+	}
+
+	str(&c.HTTP.Addr, "http-addr", "HTTP_ADDR", "адрес HTTP-сервера")
+	duration(&c.HTTP.ReadHeaderTimeout, "http-read-header-timeout", "HTTP_READ_HEADER_TIMEOUT", "таймаут чтения заголовков запроса")
+	duration(&c.HTTP.ReadTimeout, "http-read-timeout", "HTTP_READ_TIMEOUT", "таймаут чтения запроса")
+	duration(&c.HTTP.WriteTimeout, "http-write-timeout", "HTTP_WRITE_TIMEOUT", "таймаут записи ответа")
+	duration(&c.HTTP.IdleTimeout, "http-idle-timeout", "HTTP_IDLE_TIMEOUT", "таймаут простоя keep-alive соединения")
+	integer(&c.HTTP.MaxHeaderBytes, "http-max-header-bytes", "HTTP_MAX_HEADER_BYTES", "максимальный размер заголовков запроса")
+	duration(&c.HTTP.ShutdownDelay, "shutdown-delay", "SHUTDOWN_DELAY", "пауза после перехода в неготовность перед дренажем запросов")
+	duration(&c.HTTP.ShutdownTimeout, "shutdown-timeout", "SHUTDOWN_TIMEOUT", "срок корректной остановки сервера")
+
+	str(&c.GRPC.Addr, "grpc-addr", "GRPC_ADDR", "адрес gRPC-сервера, пусто — отключён")
+
+	str(&c.Database.URL, "database-url", "DATABASE_URL", "строка подключения к PostgreSQL")
+	conns(&c.Database.MaxConns, "db-max-conns", "DB_MAX_CONNS", "максимум соединений в пуле")
+	conns(&c.Database.MinConns, "db-min-conns", "DB_MIN_CONNS", "минимум соединений в пуле")
+	duration(&c.Database.MaxConnLifetime, "db-max-conn-lifetime", "DB_MAX_CONN_LIFETIME", "максимальное время жизни соединения")
+	duration(&c.Database.MaxConnIdleTime, "db-max-conn-idle-time", "DB_MAX_CONN_IDLE_TIME", "максимальное время простоя соединения")
+	duration(&c.Database.HealthCheckPeriod, "db-health-check-period", "DB_HEALTH_CHECK_PERIOD", "период проверки соединений пула")
+	duration(&c.Database.ConnectTimeout, "db-connect-timeout", "DB_CONNECT_TIMEOUT", "срок ожидания базы при старте")
+	duration(&c.Database.ConnectMinBackoff, "db-connect-min-backoff", "DB_CONNECT_MIN_BACKOFF", "начальная задержка между попытками подключения")
+	duration(&c.Database.ConnectMaxBackoff, "db-connect-max-backoff", "DB_CONNECT_MAX_BACKOFF", "максимальная задержка между попытками подключения")
+	duration(&c.Database.PingInterval, "db-ping-interval", "DB_PING_INTERVAL", "период проверки доступности базы")
+
+	str(&c.Log.Level, "log-level", "LOG_LEVEL", "уровень журналирования: debug, info, warn, error")
+	str(&c.Log.Format, "log-format", "LOG_FORMAT", "формат журнала: json, text")
+
+	str(&c.Tracing.Exporter, "tracing-exporter", "TRACING_EXPORTER", "экспортер трасс: none, otlp, stdout")
+	str(&c.Tracing.Endpoint, "tracing-endpoint", "TRACING_ENDPOINT", "адрес OTLP/gRPC коллектора host:port")
+	boolean(&c.Tracing.Insecure, "tracing-insecure", "TRACING_INSECURE", "подключаться к коллектору без TLS")
+	float(&c.Tracing.SampleRatio, "tracing-sample-ratio", "TRACING_SAMPLE_RATIO", "доля трасс, начинаемых сервисом")
+	str(&c.Tracing.ServiceName, "tracing-service-name", "OTEL_SERVICE_NAME", "имя сервиса в трассах")
+
+	boolean(&c.Features.EventStream, "feature-event-stream", "FEATURE_EVENT_STREAM", "поток событий GET /events/stream")
+	boolean(&c.Features.Webhooks, "feature-webhooks", "FEATURE_WEBHOOKS", "приём вебхуков GitHub и GitLab")
+	boolean(&c.Features.GitHubSync, "feature-github-sync", "FEATURE_GITHUB_SYNC", "синхронизация ревьюеров с GitHub")
+	boolean(&c.Features.Notifications, "feature-notifications", "FEATURE_NOTIFICATIONS", "уведомления в Slack и по почте")
+	boolean(&c.Features.Auth, "feature-auth", "FEATURE_AUTH", "проверка API-токенов")
+	boolean(&c.Features.Metrics, "feature-metrics", "FEATURE_METRICS", "метрики Prometheus GET /metrics")
+	boolean(&c.Features.LoginFallback, "feature-login-fallback", "FEATURE_LOGIN_FALLBACK", "сопоставление внешних логинов с user_id и username без привязки")
+
+	str(&c.Auth.BootstrapToken, "auth-bootstrap-token", "AUTH_BOOTSTRAP_TOKEN", "токен начальной настройки с областью admin")
+	str(&c.Auth.JWT.JWKS, "jwt-jwks", "JWT_JWKS", "файл или URL с ключами JWKS, пусто — JWT не принимаются")
+	duration(&c.Auth.JWT.JWKSRefresh, "jwt-jwks-refresh", "JWT_JWKS_REFRESH", "период обновления JWKS")
+	str(&c.Auth.JWT.Issuer, "jwt-issuer", "JWT_ISSUER", "ожидаемый issuer (iss)")
+	str(&c.Auth.JWT.Audience, "jwt-audience", "JWT_AUDIENCE", "ожидаемая аудитория (aud)")
+	duration(&c.Auth.JWT.Leeway, "jwt-leeway", "JWT_LEEWAY", "допуск расхождения часов")
+	str(&c.Auth.JWT.UserClaim, "jwt-user-claim", "JWT_USER_CLAIM", "claim с идентификатором пользователя")
+	str(&c.Auth.JWT.RolesClaim, "jwt-roles-claim", "JWT_ROLES_CLAIM", "claim со списком ролей")
+	str(&c.Auth.JWT.IdentityProvider, "jwt-identity-provider", "JWT_IDENTITY_PROVIDER", "провайдер привязки для user_claim, пусто — user_claim содержит user_id")
+	str(&c.Auth.JWT.Scopes, "jwt-scopes", "JWT_SCOPES", "области доступа пользователей с JWT через запятую")
+	str(&c.Auth.JWT.ClaimScopes, "jwt-claim-scopes", "JWT_CLAIM_SCOPES", "области, которые JWT может запросить в claim scope, через запятую; admin недопустим")
+
+	integer(&c.Assignment.ReviewersPerPR, "reviewers-per-pr", "ASSIGNMENT_REVIEWERS_PER_PR", "количество ревьюеров на PR")
+	str(&c.Assignment.DeactivationPolicy, "deactivation-policy", "ASSIGNMENT_DEACTIVATION_POLICY", "политика массовой деактивации по умолчанию")
+
+	duration(&c.Events.Retention, "event-retention", "EVENT_RETENTION", "срок хранения журнала событий, 0 — бессрочно")
+	duration(&c.Events.Heartbeat, "event-heartbeat", "EVENT_HEARTBEAT", "интервал пульса потока событий")
+
+	str(&c.GitHub.Token, "github-token", "GITHUB_TOKEN", "токен GitHub для синхронизации ревьюеров")
+	str(&c.GitHub.APIURL, "github-api-url", "GITHUB_API_URL", "адрес GitHub API")
+	str(&c.GitHub.WebhookSecret, "github-webhook-secret", "GITHUB_WEBHOOK_SECRET", "секрет подписи вебхуков GitHub")
+	str(&c.GitLab.WebhookToken, "gitlab-webhook-token", "GITLAB_WEBHOOK_TOKEN", "токен вебхуков GitLab")
+
+	str(&c.Slack.URL, "slack-url", "SLACK_URL", "URL вебхука или chat.postMessage Slack")
+	str(&c.Slack.Token, "slack-token", "SLACK_TOKEN", "токен бота Slack")
+	str(&c.Slack.Channel, "slack-channel", "SLACK_CHANNEL", "канал Slack по умолчанию")
+	str(&c.Slack.TeamChannels, "slack-team-channels", "SLACK_TEAM_CHANNELS", "каналы команд: team=channel,...")
+	boolean(&c.Slack.DirectMessages, "slack-dm", "SLACK_DM", "личные сообщения ревьюерам (требует SLACK_TOKEN)")
+	str(&c.Slack.TemplateAssigned, "slack-template-assigned", "SLACK_TEMPLATE_ASSIGNED", "шаблон сообщения о назначении")
+	str(&c.Slack.TemplateReplaced, "slack-template-replaced", "SLACK_TEMPLATE_REPLACED", "шаблон сообщения о замене")
+
+	str(&c.Email.Addr, "smtp-addr", "SMTP_ADDR", "адрес SMTP-сервера host:port")
+	str(&c.Email.Username, "smtp-username", "SMTP_USERNAME", "пользователь SMTP")
+	str(&c.Email.Password, "smtp-password", "SMTP_PASSWORD", "пароль SMTP")
+	str(&c.Email.From, "smtp-from", "SMTP_FROM", "адрес отправителя")
+	str(&c.Email.Subject, "email-template-subject", "EMAIL_TEMPLATE_SUBJECT", "шаблон темы письма")
+	str(&c.Email.TemplateAssigned, "email-template-assigned", "EMAIL_TEMPLATE_ASSIGNED", "шаблон письма о назначении")
+	str(&c.Email.TemplateReplaced, "email-template-replaced", "EMAIL_TEMPLATE_REPLACED", "шаблон письма о замене")
+	str(&c.Email.TemplateDigest, "email-template-digest", "EMAIL_TEMPLATE_DIGEST", "шаблон ежедневного дайджеста")
+	str(&c.Email.DigestAt, "email-digest-at", "EMAIL_DIGEST_AT", "время дайджеста HH:MM")
+
+	return bindings
+}
+
+// int32Value — flag.Value для полей пула pgx типа int32.
+type int32Value int32
+
+func (v *int32Value) Set(s string) error {
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return err
+	}
+	*v = int32Value(n)
+	return nil
+}
+
+func (v *int32Value) String() string {
+	if v == nil {
+		return "0"
+	}
+	return strconv.Itoa(int(*v))
+}
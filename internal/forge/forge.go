@@ -0,0 +1,134 @@
+// Package forge переводит события внешних систем хранения кода (GitHub, GitLab)
+// в операции store. Адаптеры конкретных систем разбирают свои payload в Event,
+// а Dispatcher применяет их единообразно.
+package forge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// Action — нормализованное действие над pull/merge request.
+type Action string
+
+// Поддерживаемые действия.
+const (
+	ActionOpened         Action = "opened"
+	ActionMerged         Action = "merged"
+	ActionReadyForReview Action = "ready_for_review"
+	ActionClosed         Action = "closed"
+	ActionReopened       Action = "reopened"
+)
+
+// ErrIgnored возвращается адаптером, если событие не требует действий.
+var ErrIgnored = errors.New("event ignored")
+
+// ErrUnknownUser возвращается, если автора события не удалось сопоставить с пользователем.
+var ErrUnknownUser = errors.New("unknown user")
+
+// Event — событие внешней системы, приведённое к общему виду.
+type Event struct {
+	Provider   string
+	Action     Action
+	Repository string
+	Number     int
+	Title      string
+	URL        string
+	// AuthorID — идентификатор автора у провайдера, по которому ищется привязка
+	// в user_identities: логин в GitHub, числовой id пользователя в GitLab.
+	AuthorID string
+	// AuthorLogin — логин автора, если он известен; используется только при LoginFallback.
+	AuthorLogin string
+	Draft       bool
+}
+
+// PullRequestID возвращает внутренний идентификатор PR для события.
+func (e Event) PullRequestID() string {
+	return fmt.Sprintf("%s:%s#%d", e.Provider, e.Repository, e.Number)
+}
+
+// Result описывает результат применения события.
+type Result struct {
+	Action        Action              `json:"action"`
+	PullRequestID string              `json:"pull_request_id"`
+	PullRequest   *models.PullRequest `json:"pr,omitempty"`
+	Note          string              `json:"note,omitempty"`
+}
+
+// Dispatcher применяет события внешних систем к store.
+type Dispatcher struct {
+	store *store.Store
+}
+
+// NewDispatcher создает новый Dispatcher.
+func NewDispatcher(st *store.Store) *Dispatcher {
+	return &Dispatcher{store: st}
+}
+
+// Apply выполняет операцию store, соответствующую событию.
+func (d *Dispatcher) Apply(ctx context.Context, ev Event) (*Result, error) {
+	res := &Result{Action: ev.Action, PullRequestID: ev.PullRequestID()}
+
+	switch ev.Action {
+	case ActionOpened:
+		authorID, err := d.store.ResolveUser(ctx, ev.Provider, ev.AuthorID, ev.AuthorLogin)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return nil, fmt.Errorf("%s author %s: %w", ev.Provider, ev.AuthorID, ErrUnknownUser)
+			}
+			return nil, err
+		}
+		ref := models.ExternalRef{
+			Provider:   ev.Provider,
+			Repository: ev.Repository,
+			Number:     ev.Number,
+			URL:        ev.URL,
+		}
+		pr, err := d.store.CreateLinkedPR(ctx, res.PullRequestID, ev.Title, authorID, ev.Draft, ref)
+		if err != nil {
+			if errors.Is(err, store.ErrPRExists) {
+				res.Note = "pull request already exists"
+				return res, nil
+			}
+			return nil, err
+		}
+		res.PullRequest = pr
+
+	case ActionReadyForReview:
+		pr, err := d.store.MarkReadyForReview(ctx, res.PullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		res.PullRequest = pr
+
+	case ActionMerged:
+		pr, err := d.store.MergePR(ctx, res.PullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		res.PullRequest = pr
+
+	case ActionClosed:
+		pr, err := d.store.ClosePR(ctx, res.PullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		res.PullRequest = pr
+
+	case ActionReopened:
+		pr, err := d.store.ReopenPR(ctx, res.PullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		res.PullRequest = pr
+
+	default:
+		return nil, fmt.Errorf("action %s: %w", ev.Action, ErrIgnored)
+	}
+
+	return res, nil
+}
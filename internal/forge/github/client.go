@@ -0,0 +1,153 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL — адрес публичного GitHub REST API.
+const DefaultBaseURL = "https://api.github.com"
+
+// Client — минимальный клиент GitHub REST API для управления запрошенными ревьюерами.
+// BaseURL можно направить на GitHub Enterprise или локальный mock-сервер.
+type Client struct {
+	BaseURL    string
+	Token      string
+	HTTPClient *http.Client
+
+	// MaxRetries — число повторов после первой попытки.
+	MaxRetries int
+	// MinBackoff и MaxBackoff ограничивают экспоненциальную задержку между попытками.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// NewClient создает клиент с настройками по умолчанию.
+func NewClient(baseURL, token string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		Token:      token,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		MaxRetries: 4,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// APIError — ответ GitHub с неуспешным статусом.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("github api: %d %s", e.StatusCode, e.Message)
+}
+
+// retryable сообщает, имеет ли смысл повторить запрос.
+func (e *APIError) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+type reviewersRequest struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+// RequestReviewers запрашивает ревью у пользователей в PR repo#number.
+func (c *Client) RequestReviewers(ctx context.Context, repo string, number int, logins []string) error {
+	return c.reviewers(ctx, http.MethodPost, repo, number, logins)
+}
+
+// RemoveRequestedReviewers снимает запрос ревью с пользователей в PR repo#number.
+func (c *Client) RemoveRequestedReviewers(ctx context.Context, repo string, number int, logins []string) error {
+	return c.reviewers(ctx, http.MethodDelete, repo, number, logins)
+}
+
+func (c *Client) reviewers(ctx context.Context, method, repo string, number int, logins []string) error {
+	if len(logins) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(reviewersRequest{Reviewers: logins})
+	if err != nil {
+		return fmt.Errorf("encode reviewers: %w", err)
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls/%d/requested_reviewers", c.BaseURL, repo, number)
+	return c.do(ctx, method, url, body)
+}
+
+// do выполняет запрос, повторяя его с экспоненциальной задержкой
+// при сетевых ошибках, 429 и 5xx.
+func (c *Client) do(ctx context.Context, method, url string, body []byte) error {
+	var lastErr error
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(c.backoff(attempt)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		err := c.send(ctx, method, url, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if apiErr, ok := err.(*APIError); ok && !apiErr.retryable() {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return fmt.Errorf("%s %s: %w", method, url, lastErr)
+}
+
+func (c *Client) send(ctx context.Context, method, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return &APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(msg))}
+}
+
+// backoff возвращает задержку перед попыткой attempt с «полным» джиттером.
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.MinBackoff << (attempt - 1)
+	if d <= 0 || d > c.MaxBackoff {
+		d = c.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
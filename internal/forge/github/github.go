@@ -0,0 +1,101 @@
+// Package github разбирает вебхуки GitHub pull_request в события forge.
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/2Empty/review-assigner/internal/forge"
+)
+
+// Provider — имя провайдера в событиях и внешних ссылках.
+const Provider = "github"
+
+// Заголовки вебхуков GitHub.
+const (
+	HeaderEvent     = "X-GitHub-Event"
+	HeaderSignature = "X-Hub-Signature-256"
+)
+
+// pullRequestPayload — нужное подмножество payload события pull_request.
+type pullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+		Draft   bool   `json:"draft"`
+		Merged  bool   `json:"merged"`
+		User    struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// VerifySignature проверяет заголовок X-Hub-Signature-256 (HMAC-SHA256 тела запроса).
+func VerifySignature(secret, body []byte, header string) bool {
+	sig, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// ParseEvent переводит вебхук GitHub в событие forge.
+// Для событий, не требующих действий, возвращается forge.ErrIgnored.
+func ParseEvent(eventType string, body []byte) (forge.Event, error) {
+	if eventType != "pull_request" {
+		return forge.Event{}, fmt.Errorf("event %s: %w", eventType, forge.ErrIgnored)
+	}
+
+	var p pullRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return forge.Event{}, fmt.Errorf("decode pull_request payload: %w", err)
+	}
+	if p.Repository.FullName == "" || p.PullRequest.Number == 0 {
+		return forge.Event{}, fmt.Errorf("pull_request payload: repository and number are required")
+	}
+
+	ev := forge.Event{
+		Provider:    Provider,
+		Repository:  p.Repository.FullName,
+		Number:      p.PullRequest.Number,
+		Title:       p.PullRequest.Title,
+		URL:         p.PullRequest.HTMLURL,
+		AuthorID:    p.PullRequest.User.Login,
+		AuthorLogin: p.PullRequest.User.Login,
+		Draft:       p.PullRequest.Draft,
+	}
+
+	switch {
+	case p.Action == "opened":
+		ev.Action = forge.ActionOpened
+	case p.Action == "ready_for_review":
+		ev.Action = forge.ActionReadyForReview
+	case p.Action == "closed" && p.PullRequest.Merged:
+		ev.Action = forge.ActionMerged
+	case p.Action == "closed":
+		ev.Action = forge.ActionClosed
+	case p.Action == "reopened":
+		ev.Action = forge.ActionReopened
+	default:
+		return forge.Event{}, fmt.Errorf("action %s: %w", p.Action, forge.ErrIgnored)
+	}
+
+	return ev, nil
+}
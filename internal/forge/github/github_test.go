@@ -0,0 +1,110 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/2Empty/review-assigner/internal/forge"
+)
+
+var testSecret = []byte("webhook-secret")
+
+func sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return body
+}
+
+func TestVerifySignature(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	if err != nil || len(fixtures) == 0 {
+		t.Fatalf("no fixtures: %v", err)
+	}
+
+	for _, path := range fixtures {
+		body := readFixture(t, filepath.Base(path))
+		tampered := append([]byte{}, body...)
+		tampered[len(tampered)/2] ^= 1
+
+		tests := []struct {
+			name   string
+			body   []byte
+			header string
+			want   bool
+		}{
+			{"valid", body, sign(testSecret, body), true},
+			{"tampered body", tampered, sign(testSecret, body), false},
+			{"wrong secret", body, sign([]byte("other"), body), false},
+			{"missing header", body, "", false},
+			{"missing prefix", body, sign(testSecret, body)[len("sha256="):], false},
+			{"sha1 prefix", body, "sha1=" + sign(testSecret, body)[len("sha256="):], false},
+			{"not hex", body, "sha256=zz", false},
+		}
+		for _, tt := range tests {
+			t.Run(filepath.Base(path)+"/"+tt.name, func(t *testing.T) {
+				if got := VerifySignature(testSecret, tt.body, tt.header); got != tt.want {
+					t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		action  forge.Action
+		number  int
+		draft   bool
+	}{
+		{"pull_request_opened.json", forge.ActionOpened, 42, false},
+		{"pull_request_opened_draft.json", forge.ActionOpened, 43, true},
+		{"pull_request_ready_for_review.json", forge.ActionReadyForReview, 43, false},
+		{"pull_request_closed_merged.json", forge.ActionMerged, 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			ev, err := ParseEvent("pull_request", readFixture(t, tt.fixture))
+			if err != nil {
+				t.Fatalf("ParseEvent() error = %v", err)
+			}
+			if ev.Action != tt.action || ev.Draft != tt.draft {
+				t.Errorf("action = %s, draft = %v; want %s, %v", ev.Action, ev.Draft, tt.action, tt.draft)
+			}
+			if ev.Provider != Provider || ev.Repository != "acme/payments" || ev.Number != tt.number || ev.AuthorID != "alice" || ev.AuthorLogin != "alice" {
+				t.Errorf("unexpected event %+v", ev)
+			}
+			if got, want := ev.PullRequestID(), fmt.Sprintf("github:acme/payments#%d", tt.number); got != want {
+				t.Errorf("PullRequestID() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestParseEventIgnored(t *testing.T) {
+	body := readFixture(t, "pull_request_opened.json")
+	if _, err := ParseEvent("push", body); !errors.Is(err, forge.ErrIgnored) {
+		t.Errorf("push: error = %v, want ErrIgnored", err)
+	}
+	if _, err := ParseEvent("pull_request", []byte(`{"action":"labeled","number":1,"pull_request":{"number":1},"repository":{"full_name":"a/b"}}`)); !errors.Is(err, forge.ErrIgnored) {
+		t.Errorf("labeled: error = %v, want ErrIgnored", err)
+	}
+	if _, err := ParseEvent("pull_request", []byte(`{"action":"opened"}`)); err == nil || errors.Is(err, forge.ErrIgnored) {
+		t.Errorf("incomplete payload: error = %v, want validation error", err)
+	}
+}
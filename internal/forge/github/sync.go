@@ -0,0 +1,128 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// Directory — данные store, нужные для синхронизации ревьюеров. Пользователь
+// без логина GitHub — store.ErrNotFound из ExternalLogin.
+type Directory interface {
+	GetExternalRef(ctx context.Context, prID string) (*models.ExternalRef, error)
+	ExternalLogin(ctx context.Context, userID, provider string) (string, error)
+}
+
+// ReviewerSync переносит назначения ревьюеров в GitHub.
+// Реализует store.Listener: события ставятся в очередь и обрабатываются в Run.
+type ReviewerSync struct {
+	client *Client
+	dir    Directory
+	queue  chan []models.Event
+}
+
+// NewReviewerSync создает синхронизатор с очередью заданного размера.
+func NewReviewerSync(client *Client, dir Directory, queueSize int) *ReviewerSync {
+	if queueSize <= 0 {
+		queueSize = 256
+	}
+	return &ReviewerSync{
+		client: client,
+		dir:    dir,
+		queue:  make(chan []models.Event, queueSize),
+	}
+}
+
+// HandleEvents ставит события в очередь; при переполнении они отбрасываются.
+func (s *ReviewerSync) HandleEvents(events []models.Event) {
+	select {
+	case s.queue <- events:
+	default:
+		slog.Warn("GitHub reviewer sync queue is full, dropping events", "events", len(events))
+	}
+}
+
+// Run обрабатывает очередь до отмены контекста.
+func (s *ReviewerSync) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case events := <-s.queue:
+			for _, e := range events {
+				if !e.IsReviewerChange() {
+					continue
+				}
+				if err := s.apply(ctx, e); err != nil {
+					slog.ErrorContext(ctx, "Failed to sync reviewer to GitHub", "pr_id", e.PullRequestID, "user_id", e.UserID, "team", e.TeamName, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// apply переносит событие в GitHub. Запрос нового ревьюера и снятие прежнего
+// выполняются независимо, чтобы ошибка одного не отменяла другое.
+func (s *ReviewerSync) apply(ctx context.Context, e models.Event) error {
+	ref, err := s.dir.GetExternalRef(ctx, e.PullRequestID)
+	if errors.Is(err, store.ErrNotFound) {
+		// PR не связан с внешней системой — синхронизировать нечего
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get external ref: %w", err)
+	}
+	if ref.Provider != Provider {
+		return nil
+	}
+
+	var errs []error
+	if e.UserID != "" {
+		errs = append(errs, s.request(ctx, ref, e.UserID))
+	}
+	if e.PreviousUserID != "" {
+		errs = append(errs, s.remove(ctx, ref, e.PreviousUserID))
+	}
+	return errors.Join(errs...)
+}
+
+func (s *ReviewerSync) request(ctx context.Context, ref *models.ExternalRef, userID string) error {
+	login, ok, err := s.login(ctx, ref, userID)
+	if err != nil || !ok {
+		return err
+	}
+	if err := s.client.RequestReviewers(ctx, ref.Repository, ref.Number, []string{login}); err != nil {
+		return fmt.Errorf("request reviewer %s: %w", userID, err)
+	}
+	return nil
+}
+
+func (s *ReviewerSync) remove(ctx context.Context, ref *models.ExternalRef, userID string) error {
+	login, ok, err := s.login(ctx, ref, userID)
+	if err != nil || !ok {
+		return err
+	}
+	if err := s.client.RemoveRequestedReviewers(ctx, ref.Repository, ref.Number, []string{login}); err != nil {
+		return fmt.Errorf("remove reviewer %s: %w", userID, err)
+	}
+	return nil
+}
+
+// login возвращает логин пользователя в GitHub. Пользователь без привязки
+// пропускается: имя в сервисе может принадлежать чужой учётной записи GitHub.
+func (s *ReviewerSync) login(ctx context.Context, ref *models.ExternalRef, userID string) (string, bool, error) {
+	login, err := s.dir.ExternalLogin(ctx, userID, Provider)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			slog.WarnContext(ctx, "Skipping GitHub reviewer sync for user without GitHub identity",
+				"user_id", userID, "repository", ref.Repository, "number", ref.Number)
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("get GitHub login of %s: %w", userID, err)
+	}
+	return login, true, nil
+}
@@ -0,0 +1,113 @@
+// Package gitlab разбирает вебхуки GitLab Merge Request Hook в события forge.
+package gitlab
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/2Empty/review-assigner/internal/forge"
+)
+
+// Provider — имя провайдера в событиях и внешних ссылках.
+const Provider = "gitlab"
+
+// Заголовки вебхуков GitLab.
+const (
+	HeaderEvent = "X-Gitlab-Event"
+	HeaderToken = "X-Gitlab-Token"
+)
+
+// mergeRequestEvent — значение заголовка X-Gitlab-Event для событий merge request.
+const mergeRequestEvent = "Merge Request Hook"
+
+// mergeRequestPayload — нужное подмножество payload Merge Request Hook.
+type mergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	// User — кто вызвал событие; это не обязательно автор merge request.
+	User struct {
+		ID       int    `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		AuthorID       int    `json:"author_id"`
+		Title          string `json:"title"`
+		URL            string `json:"url"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft *struct {
+			Previous bool `json:"previous"`
+			Current  bool `json:"current"`
+		} `json:"draft"`
+	} `json:"changes"`
+}
+
+// VerifyToken сравнивает заголовок X-Gitlab-Token с настроенным секретом.
+func VerifyToken(secret, header string) bool {
+	if secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(header)) == 1
+}
+
+// ParseEvent переводит вебхук GitLab в событие forge.
+// Для событий, не требующих действий, возвращается forge.ErrIgnored.
+func ParseEvent(eventType string, body []byte) (forge.Event, error) {
+	if eventType != mergeRequestEvent {
+		return forge.Event{}, fmt.Errorf("event %s: %w", eventType, forge.ErrIgnored)
+	}
+
+	var p mergeRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return forge.Event{}, fmt.Errorf("decode merge_request payload: %w", err)
+	}
+	attrs := p.ObjectAttributes
+	if p.Project.PathWithNamespace == "" || attrs.IID == 0 || attrs.AuthorID == 0 {
+		return forge.Event{}, fmt.Errorf("merge_request payload: project, iid and author_id are required")
+	}
+
+	// Payload содержит только id автора; его логин известен, лишь когда автор
+	// сам вызвал событие. Привязка gitlab хранит числовой id пользователя.
+	ev := forge.Event{
+		Provider:   Provider,
+		Repository: p.Project.PathWithNamespace,
+		Number:     attrs.IID,
+		Title:      attrs.Title,
+		URL:        attrs.URL,
+		AuthorID:   strconv.Itoa(attrs.AuthorID),
+		Draft:      attrs.Draft || attrs.WorkInProgress,
+	}
+	if p.User.ID == attrs.AuthorID {
+		ev.AuthorLogin = p.User.Username
+	}
+
+	switch attrs.Action {
+	case "open":
+		ev.Action = forge.ActionOpened
+	case "merge":
+		ev.Action = forge.ActionMerged
+	case "close":
+		ev.Action = forge.ActionClosed
+	case "reopen":
+		ev.Action = forge.ActionReopened
+	case "update":
+		// Снятие статуса Draft GitLab присылает как update с изменением поля draft.
+		if d := p.Changes.Draft; d != nil && d.Previous && !d.Current {
+			ev.Action = forge.ActionReadyForReview
+			break
+		}
+		return forge.Event{}, fmt.Errorf("action update: %w", forge.ErrIgnored)
+	default:
+		return forge.Event{}, fmt.Errorf("action %s: %w", attrs.Action, forge.ErrIgnored)
+	}
+
+	return ev, nil
+}
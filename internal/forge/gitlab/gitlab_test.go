@@ -0,0 +1,97 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/2Empty/review-assigner/internal/forge"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return body
+}
+
+func TestVerifyToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		header string
+		want   bool
+	}{
+		{"valid", "dev-token", "dev-token", true},
+		{"wrong token", "dev-token", "other-token", false},
+		{"prefix of token", "dev-token", "dev-", false},
+		{"missing header", "dev-token", "", false},
+		{"empty secret", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyToken(tt.secret, tt.header); got != tt.want {
+				t.Errorf("VerifyToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		action      forge.Action
+		number      int
+		authorLogin string
+	}{
+		{"merge_request_open.json", forge.ActionOpened, 7, "alice"},
+		{"merge_request_update_ready.json", forge.ActionReadyForReview, 8, "alice"},
+		// Мержит bob, но автор — пользователь 17, и его логин в payload неизвестен
+		{"merge_request_merge.json", forge.ActionMerged, 7, ""},
+		{"merge_request_close.json", forge.ActionClosed, 9, "alice"},
+		{"merge_request_reopen.json", forge.ActionReopened, 9, "alice"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			ev, err := ParseEvent(mergeRequestEvent, readFixture(t, tt.fixture))
+			if err != nil {
+				t.Fatalf("ParseEvent() error = %v", err)
+			}
+			if ev.Action != tt.action || ev.Draft {
+				t.Errorf("action = %s, draft = %v; want %s, false", ev.Action, ev.Draft, tt.action)
+			}
+			if ev.Provider != Provider || ev.Repository != "fin/ledger" || ev.Number != tt.number {
+				t.Errorf("unexpected event %+v", ev)
+			}
+			if ev.AuthorID != "17" || ev.AuthorLogin != tt.authorLogin {
+				t.Errorf("author = %q/%q, want 17/%q", ev.AuthorID, ev.AuthorLogin, tt.authorLogin)
+			}
+			if got, want := ev.PullRequestID(), fmt.Sprintf("gitlab:fin/ledger#%d", tt.number); got != want {
+				t.Errorf("PullRequestID() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestParseEventIgnored(t *testing.T) {
+	body := readFixture(t, "merge_request_open.json")
+	if _, err := ParseEvent("Push Hook", body); !errors.Is(err, forge.ErrIgnored) {
+		t.Errorf("push: error = %v, want ErrIgnored", err)
+	}
+	update := []byte(`{"project":{"path_with_namespace":"a/b"},"object_attributes":{"iid":1,"author_id":1,"action":"update"}}`)
+	if _, err := ParseEvent(mergeRequestEvent, update); !errors.Is(err, forge.ErrIgnored) {
+		t.Errorf("update without draft change: error = %v, want ErrIgnored", err)
+	}
+	approved := []byte(`{"project":{"path_with_namespace":"a/b"},"object_attributes":{"iid":1,"author_id":1,"action":"approved"}}`)
+	if _, err := ParseEvent(mergeRequestEvent, approved); !errors.Is(err, forge.ErrIgnored) {
+		t.Errorf("approved: error = %v, want ErrIgnored", err)
+	}
+	noAuthor := []byte(`{"project":{"path_with_namespace":"a/b"},"object_attributes":{"iid":1,"action":"open"}}`)
+	if _, err := ParseEvent(mergeRequestEvent, noAuthor); err == nil || errors.Is(err, forge.ErrIgnored) {
+		t.Errorf("missing author_id: error = %v, want validation error", err)
+	}
+}
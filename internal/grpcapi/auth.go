@@ -0,0 +1,80 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/2Empty/review-assigner/internal/auth"
+	pb "github.com/2Empty/review-assigner/internal/grpcapi/reviewassignerv1"
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// methodScopes — области доступа методов сервиса, те же, что у маршрутов HTTP API.
+var methodScopes = map[string]string{
+	pb.ReviewAssigner_CreateTeam_FullMethodName:          models.ScopeTeamAdmin,
+	pb.ReviewAssigner_GetTeam_FullMethodName:             models.ScopeRead,
+	pb.ReviewAssigner_ListTeams_FullMethodName:           models.ScopeRead,
+	pb.ReviewAssigner_SetTeamRules_FullMethodName:        models.ScopeTeamAdmin,
+	pb.ReviewAssigner_SyncTeams_FullMethodName:           models.ScopeTeamAdmin,
+	pb.ReviewAssigner_SetUserActive_FullMethodName:       models.ScopeTeamAdmin,
+	pb.ReviewAssigner_GetUser_FullMethodName:             models.ScopeRead,
+	pb.ReviewAssigner_SearchUsers_FullMethodName:         models.ScopeRead,
+	pb.ReviewAssigner_GetUserReviews_FullMethodName:      models.ScopeRead,
+	pb.ReviewAssigner_DeactivateTeamUsers_FullMethodName: models.ScopeTeamAdmin,
+	pb.ReviewAssigner_ActivateTeamUsers_FullMethodName:   models.ScopeTeamAdmin,
+	pb.ReviewAssigner_SetIdentity_FullMethodName:         models.ScopeTeamAdmin,
+	pb.ReviewAssigner_GetIdentities_FullMethodName:       models.ScopeRead,
+	pb.ReviewAssigner_DeleteIdentity_FullMethodName:      models.ScopeTeamAdmin,
+	pb.ReviewAssigner_LookupIdentity_FullMethodName:      models.ScopeRead,
+	pb.ReviewAssigner_CreatePR_FullMethodName:            models.ScopePRWrite,
+	pb.ReviewAssigner_MergePR_FullMethodName:             models.ScopePRWrite,
+	pb.ReviewAssigner_ReassignReviewer_FullMethodName:    models.ScopePRWrite,
+	pb.ReviewAssigner_GetStats_FullMethodName:            models.ScopeStats,
+}
+
+// teamMethods — действия над командой: пользователи из JWT допускаются к ним
+// без области доступа метода, права на команду проверяет сам метод.
+var teamMethods = map[string]bool{
+	pb.ReviewAssigner_SetUserActive_FullMethodName:       true,
+	pb.ReviewAssigner_DeactivateTeamUsers_FullMethodName: true,
+	pb.ReviewAssigner_ActivateTeamUsers_FullMethodName:   true,
+	pb.ReviewAssigner_ReassignReviewer_FullMethodName:    true,
+}
+
+// AuthInterceptor проверяет токен из метаданных authorization: Bearer <token>
+// и область доступа метода. Методы вне сервиса, например health, не проверяются.
+func AuthInterceptor(a *auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		scope, ok := methodScopes[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		var token string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get("authorization"); len(v) > 0 {
+				token = auth.BearerToken(v[0])
+			}
+		}
+		p, err := a.Authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, auth.ErrUnauthenticated) {
+				return nil, withReason(codes.Unauthenticated, "UNAUTHORIZED", err.Error())
+			}
+			return nil, toStatus(err)
+		}
+		check := p.Require
+		if teamMethods[info.FullMethod] {
+			check = p.RequireTeam
+		}
+		if err := check(scope); err != nil {
+			return nil, withReason(codes.PermissionDenied, "FORBIDDEN", err.Error())
+		}
+		ctx = auth.WithPrincipal(ctx, p)
+		return handler(store.WithActor(ctx, p.Actor()), req)
+	}
+}
@@ -0,0 +1,206 @@
+package grpcapi
+
+import (
+	pb "github.com/2Empty/review-assigner/internal/grpcapi/reviewassignerv1"
+	"github.com/2Empty/review-assigner/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func userToPB(u *models.User) *pb.User {
+	if u == nil {
+		return nil
+	}
+	return &pb.User{
+		UserId:   u.UserID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+		Role:     u.Role,
+	}
+}
+
+func userDetailsToPB(u *models.UserDetails) *pb.UserDetails {
+	return &pb.UserDetails{User: userToPB(&u.User), OpenReviews: int32(u.OpenReviews)}
+}
+
+func identitiesToPB(identities []models.Identity) []*pb.Identity {
+	result := make([]*pb.Identity, 0, len(identities))
+	for _, i := range identities {
+		result = append(result, &pb.Identity{UserId: i.UserID, Provider: i.Provider, ExternalId: i.ExternalID})
+	}
+	return result
+}
+
+func identitiesFromPB(identities []*pb.Identity) []models.Identity {
+	result := make([]models.Identity, 0, len(identities))
+	for _, i := range identities {
+		result = append(result, models.Identity{UserID: i.GetUserId(), Provider: i.GetProvider(), ExternalID: i.GetExternalId()})
+	}
+	return result
+}
+
+func rulesToPB(rules []models.ReviewRule) []*pb.ReviewRule {
+	result := make([]*pb.ReviewRule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, &pb.ReviewRule{Role: r.Role, MinCount: int32(r.MinCount)})
+	}
+	return result
+}
+
+func rulesFromPB(rules []*pb.ReviewRule) []models.ReviewRule {
+	result := make([]models.ReviewRule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, models.ReviewRule{Role: r.GetRole(), MinCount: int(r.GetMinCount())})
+	}
+	return result
+}
+
+func teamToPB(t *models.Team) *pb.Team {
+	team := &pb.Team{TeamName: t.TeamName, ReviewRules: rulesToPB(t.ReviewRules)}
+	for _, m := range t.Members {
+		team.Members = append(team.Members, &pb.TeamMember{
+			UserId:     m.UserID,
+			Username:   m.Username,
+			IsActive:   m.IsActive,
+			Role:       m.Role,
+			Identities: identitiesToPB(m.Identities),
+		})
+	}
+	return team
+}
+
+func teamFromPB(t *pb.Team) models.Team {
+	team := models.Team{TeamName: t.GetTeamName(), ReviewRules: rulesFromPB(t.GetReviewRules())}
+	for _, m := range t.GetMembers() {
+		team.Members = append(team.Members, models.TeamMember{
+			UserID:     m.GetUserId(),
+			Username:   m.GetUsername(),
+			IsActive:   m.GetIsActive(),
+			Role:       m.GetRole(),
+			Identities: identitiesFromPB(m.GetIdentities()),
+		})
+	}
+	return team
+}
+
+func prToPB(pr *models.PullRequest) *pb.PullRequest {
+	if pr == nil {
+		return nil
+	}
+	result := &pb.PullRequest{
+		PullRequestId:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorID,
+		Status:            pr.Status,
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         timestamppb.New(pr.CreatedAt),
+	}
+	if pr.MergedAt != nil {
+		result.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+	for _, v := range pr.RuleViolations {
+		result.RuleViolations = append(result.RuleViolations, &pb.RuleViolation{
+			Role:     v.Role,
+			Required: int32(v.Required),
+			Assigned: int32(v.Assigned),
+		})
+	}
+	return result
+}
+
+func reassignmentsToPB(moves []models.Reassignment) []*pb.Reassignment {
+	result := make([]*pb.Reassignment, 0, len(moves))
+	for _, m := range moves {
+		result = append(result, &pb.Reassignment{
+			PullRequestId: m.PullRequestID,
+			OldReviewerId: m.OldReviewerID,
+			NewReviewerId: m.NewReviewerID,
+		})
+	}
+	return result
+}
+
+func planToPB(plan *models.ChangePlan) *pb.ChangePlan {
+	if plan == nil {
+		return nil
+	}
+	return &pb.ChangePlan{
+		DryRun:        plan.DryRun,
+		UsersAffected: plan.UsersAffected,
+		Reassignments: reassignmentsToPB(plan.Reassignments),
+		NoCandidate:   plan.NoCandidate,
+	}
+}
+
+func deactivationReportToPB(r *models.DeactivationReport) *pb.DeactivationReport {
+	result := &pb.DeactivationReport{
+		TeamName:  r.TeamName,
+		Policy:    r.Policy,
+		DryRun:    r.DryRun,
+		Committed: r.Committed,
+	}
+	for _, u := range r.Users {
+		result.Users = append(result.Users, &pb.UserDeactivationResult{
+			UserId: u.UserID,
+			Status: u.Status,
+			Error:  u.Error,
+			User:   userToPB(u.User),
+		})
+	}
+	for _, o := range r.PullRequests {
+		result.PullRequests = append(result.PullRequests, &pb.PullRequestOutcome{
+			PullRequestId: o.PullRequestID,
+			OldReviewerId: o.OldReviewerID,
+			Outcome:       o.Outcome,
+			ReplacedBy:    o.ReplacedBy,
+		})
+	}
+	return result
+}
+
+func syncSpecFromPB(req *pb.SyncTeamsRequest) models.TeamSyncSpec {
+	var spec models.TeamSyncSpec
+	for _, t := range req.GetTeams() {
+		team := models.TeamSpec{TeamName: t.GetTeamName()}
+		if t.GetReplaceReviewRules() {
+			team.ReviewRules = rulesFromPB(t.GetReviewRules())
+		}
+		for _, m := range t.GetMembers() {
+			team.Members = append(team.Members, models.MemberSpec{
+				UserID:     m.GetUserId(),
+				Username:   m.GetUsername(),
+				IsActive:   m.IsActive,
+				Role:       m.GetRole(),
+				Identities: identitiesFromPB(m.GetIdentities()),
+			})
+		}
+		spec.Teams = append(spec.Teams, team)
+	}
+	return spec
+}
+
+func syncPlanToPB(plan *models.SyncPlan) *pb.SyncPlan {
+	result := &pb.SyncPlan{
+		DryRun:        plan.DryRun,
+		Reassignments: reassignmentsToPB(plan.Reassignments),
+		NoCandidate:   plan.NoCandidate,
+	}
+	for _, c := range plan.Changes {
+		result.Changes = append(result.Changes, &pb.SyncChange{
+			Action:   c.Action,
+			TeamName: c.TeamName,
+			UserId:   c.UserID,
+			FromTeam: c.FromTeam,
+			Detail:   c.Detail,
+		})
+	}
+	return result
+}
+
+func countsToPB(counts map[string]int) map[string]int32 {
+	result := make(map[string]int32, len(counts))
+	for k, v := range counts {
+		result[k] = int32(v)
+	}
+	return result
+}
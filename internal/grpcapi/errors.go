@@ -0,0 +1,71 @@
+package grpcapi
+
+import (
+	"errors"
+
+	"github.com/2Empty/review-assigner/internal/auth"
+	"github.com/2Empty/review-assigner/internal/store"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain — домен ErrorInfo в деталях ошибок.
+const errorDomain = "review-assigner"
+
+// storeErrors сопоставляет ошибки store и проверки прав кодам gRPC и кодам ErrorResponse HTTP API.
+var storeErrors = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{auth.ErrForbidden, codes.PermissionDenied, "FORBIDDEN"},
+	{store.ErrTeamNotFound, codes.NotFound, "NOT_FOUND"},
+	{store.ErrNotFound, codes.NotFound, "NOT_FOUND"},
+	{store.ErrTeamExists, codes.AlreadyExists, "TEAM_EXISTS"},
+	{store.ErrPRExists, codes.AlreadyExists, "PR_EXISTS"},
+	{store.ErrIdentityExists, codes.AlreadyExists, "IDENTITY_EXISTS"},
+	{store.ErrPRMerged, codes.FailedPrecondition, "PR_MERGED"},
+	{store.ErrPRNotOpen, codes.FailedPrecondition, "PR_NOT_OPEN"},
+	{store.ErrNotAssigned, codes.FailedPrecondition, "NOT_ASSIGNED"},
+	{store.ErrNoCandidate, codes.FailedPrecondition, "NO_CANDIDATE"},
+	{store.ErrDeactivationFailed, codes.Aborted, "DEACTIVATION_FAILED"},
+}
+
+// toStatus переводит ошибку store в статус gRPC. Код ErrorResponse
+// передаётся в деталях ErrorInfo, чтобы клиенты могли различать ошибки так же, как в HTTP API.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, e := range storeErrors {
+		if errors.Is(err, e.err) {
+			return withReason(e.code, e.reason, err.Error())
+		}
+	}
+	return withReason(codes.Internal, "INTERNAL_ERROR", err.Error())
+}
+
+// invalidArgument возвращает ошибку некорректного запроса.
+func invalidArgument(msg string) error {
+	return withReason(codes.InvalidArgument, "INVALID_REQUEST", msg)
+}
+
+// withDetail добавляет к ошибке-статусу деталь, например отчёт откатанной операции.
+func withDetail(err error, detail protoadapt.MessageV1) error {
+	detailed, derr := status.Convert(err).WithDetails(detail)
+	if derr != nil {
+		return err
+	}
+	return detailed.Err()
+}
+
+func withReason(code codes.Code, reason, msg string) error {
+	st := status.New(code, msg)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
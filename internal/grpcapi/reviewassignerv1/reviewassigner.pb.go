@@ -0,0 +1,3384 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: reviewassigner/v1/reviewassigner.proto
+
+// API сервиса назначения ревьюеров. Повторяет операции HTTP-ручек.
+
+package reviewassignerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UserDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	OpenReviews   int32                  `protobuf:"varint,2,opt,name=open_reviews,json=openReviews,proto3" json:"open_reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDetails) Reset() {
+	*x = UserDetails{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDetails) ProtoMessage() {}
+
+func (x *UserDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDetails.ProtoReflect.Descriptor instead.
+func (*UserDetails) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserDetails) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserDetails) GetOpenReviews() int32 {
+	if x != nil {
+		return x.OpenReviews
+	}
+	return 0
+}
+
+type Identity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Identity) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Identity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Identity) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Identities    []*Identity            `protobuf:"bytes,5,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIdentities() []*Identity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type ReviewRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	MinCount      int32                  `protobuf:"varint,2,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRule) Reset() {
+	*x = ReviewRule{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRule) ProtoMessage() {}
+
+func (x *ReviewRule) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRule.ProtoReflect.Descriptor instead.
+func (*ReviewRule) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReviewRule) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ReviewRule) GetMinCount() int32 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+type RuleViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Required      int32                  `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Assigned      int32                  `protobuf:"varint,3,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleViolation) Reset() {
+	*x = RuleViolation{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleViolation) ProtoMessage() {}
+
+func (x *RuleViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleViolation.ProtoReflect.Descriptor instead.
+func (*RuleViolation) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RuleViolation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RuleViolation) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *RuleViolation) GetAssigned() int32 {
+	if x != nil {
+		return x.Assigned
+	}
+	return 0
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	ReviewRules   []*ReviewRule          `protobuf:"bytes,3,rep,name=review_rules,json=reviewRules,proto3" json:"review_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Team) GetReviewRules() []*ReviewRule {
+	if x != nil {
+		return x.ReviewRules
+	}
+	return nil
+}
+
+type TeamSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MembersCount  int32                  `protobuf:"varint,2,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	ActiveCount   int32                  `protobuf:"varint,3,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamSummary) Reset() {
+	*x = TeamSummary{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSummary) ProtoMessage() {}
+
+func (x *TeamSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSummary.ProtoReflect.Descriptor instead.
+func (*TeamSummary) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TeamSummary) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamSummary) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *TeamSummary) GetActiveCount() int32 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	RuleViolations    []*RuleViolation       `protobuf:"bytes,8,rep,name=rule_violations,json=ruleViolations,proto3" json:"rule_violations,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetRuleViolations() []*RuleViolation {
+	if x != nil {
+		return x.RuleViolations
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Reassignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldReviewerId string                 `protobuf:"bytes,2,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	NewReviewerId string                 `protobuf:"bytes,3,opt,name=new_reviewer_id,json=newReviewerId,proto3" json:"new_reviewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reassignment) Reset() {
+	*x = Reassignment{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reassignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reassignment) ProtoMessage() {}
+
+func (x *Reassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reassignment.ProtoReflect.Descriptor instead.
+func (*Reassignment) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Reassignment) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *Reassignment) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *Reassignment) GetNewReviewerId() string {
+	if x != nil {
+		return x.NewReviewerId
+	}
+	return ""
+}
+
+type ChangePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	UsersAffected []string               `protobuf:"bytes,2,rep,name=users_affected,json=usersAffected,proto3" json:"users_affected,omitempty"`
+	Reassignments []*Reassignment        `protobuf:"bytes,3,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+	NoCandidate   []string               `protobuf:"bytes,4,rep,name=no_candidate,json=noCandidate,proto3" json:"no_candidate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePlan) Reset() {
+	*x = ChangePlan{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePlan) ProtoMessage() {}
+
+func (x *ChangePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePlan.ProtoReflect.Descriptor instead.
+func (*ChangePlan) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChangePlan) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ChangePlan) GetUsersAffected() []string {
+	if x != nil {
+		return x.UsersAffected
+	}
+	return nil
+}
+
+func (x *ChangePlan) GetReassignments() []*Reassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+func (x *ChangePlan) GetNoCandidate() []string {
+	if x != nil {
+		return x.NoCandidate
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTeamsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTeamsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamSummary         `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*TeamSummary {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *ListTeamsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTeamsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTeamsResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SetTeamRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ReviewRules   []*ReviewRule          `protobuf:"bytes,2,rep,name=review_rules,json=reviewRules,proto3" json:"review_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamRulesRequest) Reset() {
+	*x = SetTeamRulesRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamRulesRequest) ProtoMessage() {}
+
+func (x *SetTeamRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamRulesRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetTeamRulesRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamRulesRequest) GetReviewRules() []*ReviewRule {
+	if x != nil {
+		return x.ReviewRules
+	}
+	return nil
+}
+
+type SetTeamRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ReviewRules   []*ReviewRule          `protobuf:"bytes,2,rep,name=review_rules,json=reviewRules,proto3" json:"review_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamRulesResponse) Reset() {
+	*x = SetTeamRulesResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamRulesResponse) ProtoMessage() {}
+
+func (x *SetTeamRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamRulesResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamRulesResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetTeamRulesResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamRulesResponse) GetReviewRules() []*ReviewRule {
+	if x != nil {
+		return x.ReviewRules
+	}
+	return nil
+}
+
+type MemberSpec struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// Если не задано, участник активен.
+	IsActive      *bool       `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Role          string      `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Identities    []*Identity `protobuf:"bytes,5,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberSpec) Reset() {
+	*x = MemberSpec{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSpec) ProtoMessage() {}
+
+func (x *MemberSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSpec.ProtoReflect.Descriptor instead.
+func (*MemberSpec) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MemberSpec) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberSpec) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MemberSpec) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *MemberSpec) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MemberSpec) GetIdentities() []*Identity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type TeamSpec struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members  []*MemberSpec          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// Правила меняются только при replace_review_rules = true.
+	ReviewRules        []*ReviewRule `protobuf:"bytes,3,rep,name=review_rules,json=reviewRules,proto3" json:"review_rules,omitempty"`
+	ReplaceReviewRules bool          `protobuf:"varint,4,opt,name=replace_review_rules,json=replaceReviewRules,proto3" json:"replace_review_rules,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TeamSpec) Reset() {
+	*x = TeamSpec{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSpec) ProtoMessage() {}
+
+func (x *TeamSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSpec.ProtoReflect.Descriptor instead.
+func (*TeamSpec) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TeamSpec) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamSpec) GetMembers() []*MemberSpec {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *TeamSpec) GetReviewRules() []*ReviewRule {
+	if x != nil {
+		return x.ReviewRules
+	}
+	return nil
+}
+
+func (x *TeamSpec) GetReplaceReviewRules() bool {
+	if x != nil {
+		return x.ReplaceReviewRules
+	}
+	return false
+}
+
+type SyncTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamSpec            `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncTeamsRequest) Reset() {
+	*x = SyncTeamsRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTeamsRequest) ProtoMessage() {}
+
+func (x *SyncTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTeamsRequest.ProtoReflect.Descriptor instead.
+func (*SyncTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SyncTeamsRequest) GetTeams() []*TeamSpec {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *SyncTeamsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SyncChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromTeam      string                 `protobuf:"bytes,4,opt,name=from_team,json=fromTeam,proto3" json:"from_team,omitempty"`
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncChange) Reset() {
+	*x = SyncChange{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChange) ProtoMessage() {}
+
+func (x *SyncChange) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChange.ProtoReflect.Descriptor instead.
+func (*SyncChange) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SyncChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SyncChange) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SyncChange) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SyncChange) GetFromTeam() string {
+	if x != nil {
+		return x.FromTeam
+	}
+	return ""
+}
+
+func (x *SyncChange) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type SyncPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Changes       []*SyncChange          `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	Reassignments []*Reassignment        `protobuf:"bytes,3,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+	NoCandidate   []string               `protobuf:"bytes,4,rep,name=no_candidate,json=noCandidate,proto3" json:"no_candidate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncPlan) Reset() {
+	*x = SyncPlan{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPlan) ProtoMessage() {}
+
+func (x *SyncPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPlan.ProtoReflect.Descriptor instead.
+func (*SyncPlan) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SyncPlan) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SyncPlan) GetChanges() []*SyncChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SyncPlan) GetReassignments() []*Reassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+func (x *SyncPlan) GetNoCandidate() []string {
+	if x != nil {
+		return x.NoCandidate
+	}
+	return nil
+}
+
+type SetUserActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserActiveRequest) Reset() {
+	*x = SetUserActiveRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserActiveRequest) ProtoMessage() {}
+
+func (x *SetUserActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserActiveRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetUserActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SetUserActiveRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SetUserActiveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Заполняется в режиме dry_run.
+	Plan          *ChangePlan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserActiveResponse) Reset() {
+	*x = SetUserActiveResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserActiveResponse) ProtoMessage() {}
+
+func (x *SetUserActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetUserActiveResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetUserActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SetUserActiveResponse) GetPlan() *ChangePlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchUsersRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserDetails         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*UserDetails {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchUsersResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetUserReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsRequest) Reset() {
+	*x = GetUserReviewsRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsRequest) ProtoMessage() {}
+
+func (x *GetUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUserReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsResponse) Reset() {
+	*x = GetUserReviewsResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsResponse) ProtoMessage() {}
+
+func (x *GetUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetUserReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type DeactivateTeamUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Policy        string                 `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamUsersRequest) Reset() {
+	*x = DeactivateTeamUsersRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamUsersRequest) ProtoMessage() {}
+
+func (x *DeactivateTeamUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamUsersRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamUsersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeactivateTeamUsersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeactivateTeamUsersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *DeactivateTeamUsersRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *DeactivateTeamUsersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type UserDeactivationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	User          *User                  `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeactivationResult) Reset() {
+	*x = UserDeactivationResult{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeactivationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeactivationResult) ProtoMessage() {}
+
+func (x *UserDeactivationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeactivationResult.ProtoReflect.Descriptor instead.
+func (*UserDeactivationResult) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UserDeactivationResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDeactivationResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserDeactivationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UserDeactivationResult) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type PullRequestOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldReviewerId string                 `protobuf:"bytes,2,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	Outcome       string                 `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,4,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestOutcome) Reset() {
+	*x = PullRequestOutcome{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestOutcome) ProtoMessage() {}
+
+func (x *PullRequestOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestOutcome.ProtoReflect.Descriptor instead.
+func (*PullRequestOutcome) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PullRequestOutcome) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestOutcome) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *PullRequestOutcome) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *PullRequestOutcome) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type DeactivationReport struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	TeamName      string                    `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Policy        string                    `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	DryRun        bool                      `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Committed     bool                      `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	Users         []*UserDeactivationResult `protobuf:"bytes,5,rep,name=users,proto3" json:"users,omitempty"`
+	PullRequests  []*PullRequestOutcome     `protobuf:"bytes,6,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivationReport) Reset() {
+	*x = DeactivationReport{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivationReport) ProtoMessage() {}
+
+func (x *DeactivationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivationReport.ProtoReflect.Descriptor instead.
+func (*DeactivationReport) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeactivationReport) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeactivationReport) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *DeactivationReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeactivationReport) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *DeactivationReport) GetUsers() []*UserDeactivationResult {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *DeactivationReport) GetPullRequests() []*PullRequestOutcome {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type ActivateTeamUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Rebalance     bool                   `protobuf:"varint,3,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateTeamUsersRequest) Reset() {
+	*x = ActivateTeamUsersRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateTeamUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateTeamUsersRequest) ProtoMessage() {}
+
+func (x *ActivateTeamUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateTeamUsersRequest.ProtoReflect.Descriptor instead.
+func (*ActivateTeamUsersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ActivateTeamUsersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ActivateTeamUsersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ActivateTeamUsersRequest) GetRebalance() bool {
+	if x != nil {
+		return x.Rebalance
+	}
+	return false
+}
+
+type ActivationReport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	User            *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ReceivedReviews []*Reassignment        `protobuf:"bytes,2,rep,name=received_reviews,json=receivedReviews,proto3" json:"received_reviews,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActivationReport) Reset() {
+	*x = ActivationReport{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivationReport) ProtoMessage() {}
+
+func (x *ActivationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivationReport.ProtoReflect.Descriptor instead.
+func (*ActivationReport) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ActivationReport) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ActivationReport) GetReceivedReviews() []*Reassignment {
+	if x != nil {
+		return x.ReceivedReviews
+	}
+	return nil
+}
+
+type ActivateTeamUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Rebalance     bool                   `protobuf:"varint,2,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	Activated     []*ActivationReport    `protobuf:"bytes,3,rep,name=activated,proto3" json:"activated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateTeamUsersResponse) Reset() {
+	*x = ActivateTeamUsersResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateTeamUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateTeamUsersResponse) ProtoMessage() {}
+
+func (x *ActivateTeamUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateTeamUsersResponse.ProtoReflect.Descriptor instead.
+func (*ActivateTeamUsersResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ActivateTeamUsersResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ActivateTeamUsersResponse) GetRebalance() bool {
+	if x != nil {
+		return x.Rebalance
+	}
+	return false
+}
+
+func (x *ActivateTeamUsersResponse) GetActivated() []*ActivationReport {
+	if x != nil {
+		return x.Activated
+	}
+	return nil
+}
+
+type GetIdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentitiesRequest) Reset() {
+	*x = GetIdentitiesRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentitiesRequest) ProtoMessage() {}
+
+func (x *GetIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetIdentitiesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Identities    []*Identity            `protobuf:"bytes,2,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentitiesResponse) Reset() {
+	*x = GetIdentitiesResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentitiesResponse) ProtoMessage() {}
+
+func (x *GetIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetIdentitiesResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetIdentitiesResponse) GetIdentities() []*Identity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type DeleteIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIdentityRequest) Reset() {
+	*x = DeleteIdentityRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIdentityRequest) ProtoMessage() {}
+
+func (x *DeleteIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIdentityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteIdentityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type DeleteIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIdentityResponse) Reset() {
+	*x = DeleteIdentityResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIdentityResponse) ProtoMessage() {}
+
+func (x *DeleteIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIdentityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{40}
+}
+
+type LookupIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupIdentityRequest) Reset() {
+	*x = LookupIdentityRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupIdentityRequest) ProtoMessage() {}
+
+func (x *LookupIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LookupIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LookupIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LookupIdentityRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type CreatePRRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	DryRun          bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePRRequest) Reset() {
+	*x = CreatePRRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePRRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePRRequest) ProtoMessage() {}
+
+func (x *CreatePRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePRRequest.ProtoReflect.Descriptor instead.
+func (*CreatePRRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreatePRRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePRRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePRRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePRRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CreatePRResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pr    *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	// Заполняется в режиме dry_run.
+	Plan          *ChangePlan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePRResponse) Reset() {
+	*x = CreatePRResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePRResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePRResponse) ProtoMessage() {}
+
+func (x *CreatePRResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePRResponse.ProtoReflect.Descriptor instead.
+func (*CreatePRResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreatePRResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *CreatePRResponse) GetPlan() *ChangePlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type MergePRRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePRRequest) Reset() {
+	*x = MergePRRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePRRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePRRequest) ProtoMessage() {}
+
+func (x *MergePRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePRRequest.ProtoReflect.Descriptor instead.
+func (*MergePRRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MergePRRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	Plan          *ChangePlan            `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+func (x *ReassignReviewerResponse) GetPlan() *ChangePlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{47}
+}
+
+type Stats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalPrs      int32                  `protobuf:"varint,1,opt,name=total_prs,json=totalPrs,proto3" json:"total_prs,omitempty"`
+	PrsByStatus   map[string]int32       `protobuf:"bytes,2,rep,name=prs_by_status,json=prsByStatus,proto3" json:"prs_by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ReviewsByUser map[string]int32       `protobuf:"bytes,3,rep,name=reviews_by_user,json=reviewsByUser,proto3" json:"reviews_by_user,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ActiveUsers   int32                  `protobuf:"varint,4,opt,name=active_users,json=activeUsers,proto3" json:"active_users,omitempty"`
+	TotalTeams    int32                  `protobuf:"varint,5,opt,name=total_teams,json=totalTeams,proto3" json:"total_teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_reviewassigner_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Stats) GetTotalPrs() int32 {
+	if x != nil {
+		return x.TotalPrs
+	}
+	return 0
+}
+
+func (x *Stats) GetPrsByStatus() map[string]int32 {
+	if x != nil {
+		return x.PrsByStatus
+	}
+	return nil
+}
+
+func (x *Stats) GetReviewsByUser() map[string]int32 {
+	if x != nil {
+		return x.ReviewsByUser
+	}
+	return nil
+}
+
+func (x *Stats) GetActiveUsers() int32 {
+	if x != nil {
+		return x.ActiveUsers
+	}
+	return 0
+}
+
+func (x *Stats) GetTotalTeams() int32 {
+	if x != nil {
+		return x.TotalTeams
+	}
+	return 0
+}
+
+var File_reviewassigner_v1_reviewassigner_proto protoreflect.FileDescriptor
+
+const file_reviewassigner_v1_reviewassigner_proto_rawDesc = "" +
+	"\n" +
+	"&reviewassigner/v1/reviewassigner.proto\x12\x11reviewassigner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x01\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\"]\n" +
+	"\vUserDetails\x12+\n" +
+	"\x04user\x18\x01 \x01(\v2\x17.reviewassigner.v1.UserR\x04user\x12!\n" +
+	"\fopen_reviews\x18\x02 \x01(\x05R\vopenReviews\"`\n" +
+	"\bIdentity\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\"\xaf\x01\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12;\n" +
+	"\n" +
+	"identities\x18\x05 \x03(\v2\x1b.reviewassigner.v1.IdentityR\n" +
+	"identities\"=\n" +
+	"\n" +
+	"ReviewRule\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1b\n" +
+	"\tmin_count\x18\x02 \x01(\x05R\bminCount\"[\n" +
+	"\rRuleViolation\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\x05R\brequired\x12\x1a\n" +
+	"\bassigned\x18\x03 \x01(\x05R\bassigned\"\x9e\x01\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x127\n" +
+	"\amembers\x18\x02 \x03(\v2\x1d.reviewassigner.v1.TeamMemberR\amembers\x12@\n" +
+	"\freview_rules\x18\x03 \x03(\v2\x1d.reviewassigner.v1.ReviewRuleR\vreviewRules\"r\n" +
+	"\vTeamSummary\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12#\n" +
+	"\rmembers_count\x18\x02 \x01(\x05R\fmembersCount\x12!\n" +
+	"\factive_count\x18\x03 \x01(\x05R\vactiveCount\"\x84\x03\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x12I\n" +
+	"\x0frule_violations\x18\b \x03(\v2 .reviewassigner.v1.RuleViolationR\x0eruleViolations\"\x9b\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\x86\x01\n" +
+	"\fReassignment\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12&\n" +
+	"\x0fold_reviewer_id\x18\x02 \x01(\tR\roldReviewerId\x12&\n" +
+	"\x0fnew_reviewer_id\x18\x03 \x01(\tR\rnewReviewerId\"\xb6\x01\n" +
+	"\n" +
+	"ChangePlan\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12%\n" +
+	"\x0eusers_affected\x18\x02 \x03(\tR\rusersAffected\x12E\n" +
+	"\rreassignments\x18\x03 \x03(\v2\x1f.reviewassigner.v1.ReassignmentR\rreassignments\x12!\n" +
+	"\fno_candidate\x18\x04 \x03(\tR\vnoCandidate\"@\n" +
+	"\x11CreateTeamRequest\x12+\n" +
+	"\x04team\x18\x01 \x01(\v2\x17.reviewassigner.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"@\n" +
+	"\x10ListTeamsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"\x8d\x01\n" +
+	"\x11ListTeamsResponse\x124\n" +
+	"\x05teams\x18\x01 \x03(\v2\x1e.reviewassigner.v1.TeamSummaryR\x05teams\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"t\n" +
+	"\x13SetTeamRulesRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12@\n" +
+	"\freview_rules\x18\x02 \x03(\v2\x1d.reviewassigner.v1.ReviewRuleR\vreviewRules\"u\n" +
+	"\x14SetTeamRulesResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12@\n" +
+	"\freview_rules\x18\x02 \x03(\v2\x1d.reviewassigner.v1.ReviewRuleR\vreviewRules\"\xc2\x01\n" +
+	"\n" +
+	"MemberSpec\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12 \n" +
+	"\tis_active\x18\x03 \x01(\bH\x00R\bisActive\x88\x01\x01\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12;\n" +
+	"\n" +
+	"identities\x18\x05 \x03(\v2\x1b.reviewassigner.v1.IdentityR\n" +
+	"identitiesB\f\n" +
+	"\n" +
+	"_is_active\"\xd4\x01\n" +
+	"\bTeamSpec\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x127\n" +
+	"\amembers\x18\x02 \x03(\v2\x1d.reviewassigner.v1.MemberSpecR\amembers\x12@\n" +
+	"\freview_rules\x18\x03 \x03(\v2\x1d.reviewassigner.v1.ReviewRuleR\vreviewRules\x120\n" +
+	"\x14replace_review_rules\x18\x04 \x01(\bR\x12replaceReviewRules\"^\n" +
+	"\x10SyncTeamsRequest\x121\n" +
+	"\x05teams\x18\x01 \x03(\v2\x1b.reviewassigner.v1.TeamSpecR\x05teams\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x8f\x01\n" +
+	"\n" +
+	"SyncChange\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfrom_team\x18\x04 \x01(\tR\bfromTeam\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\"\xc6\x01\n" +
+	"\bSyncPlan\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x127\n" +
+	"\achanges\x18\x02 \x03(\v2\x1d.reviewassigner.v1.SyncChangeR\achanges\x12E\n" +
+	"\rreassignments\x18\x03 \x03(\v2\x1f.reviewassigner.v1.ReassignmentR\rreassignments\x12!\n" +
+	"\fno_candidate\x18\x04 \x03(\tR\vnoCandidate\"e\n" +
+	"\x14SetUserActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"w\n" +
+	"\x15SetUserActiveResponse\x12+\n" +
+	"\x04user\x18\x01 \x01(\v2\x17.reviewassigner.v1.UserR\x04user\x121\n" +
+	"\x04plan\x18\x02 \x01(\v2\x1d.reviewassigner.v1.ChangePlanR\x04plan\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x9d\x01\n" +
+	"\x12SearchUsersRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12 \n" +
+	"\tis_active\x18\x03 \x01(\bH\x00R\bisActive\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\f\n" +
+	"\n" +
+	"_is_active\"\x8f\x01\n" +
+	"\x13SearchUsersResponse\x124\n" +
+	"\x05users\x18\x01 \x03(\v2\x1e.reviewassigner.v1.UserDetailsR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"0\n" +
+	"\x15GetUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"{\n" +
+	"\x16GetUserReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12H\n" +
+	"\rpull_requests\x18\x02 \x03(\v2#.reviewassigner.v1.PullRequestShortR\fpullRequests\"\x85\x01\n" +
+	"\x1aDeactivateTeamUsersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\x8c\x01\n" +
+	"\x16UserDeactivationResult\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12+\n" +
+	"\x04user\x18\x04 \x01(\v2\x17.reviewassigner.v1.UserR\x04user\"\x9f\x01\n" +
+	"\x12PullRequestOutcome\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12&\n" +
+	"\x0fold_reviewer_id\x18\x02 \x01(\tR\roldReviewerId\x12\x18\n" +
+	"\aoutcome\x18\x03 \x01(\tR\aoutcome\x12\x1f\n" +
+	"\vreplaced_by\x18\x04 \x01(\tR\n" +
+	"replacedBy\"\x8d\x02\n" +
+	"\x12DeactivationReport\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1c\n" +
+	"\tcommitted\x18\x04 \x01(\bR\tcommitted\x12?\n" +
+	"\x05users\x18\x05 \x03(\v2).reviewassigner.v1.UserDeactivationResultR\x05users\x12J\n" +
+	"\rpull_requests\x18\x06 \x03(\v2%.reviewassigner.v1.PullRequestOutcomeR\fpullRequests\"p\n" +
+	"\x18ActivateTeamUsersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x1c\n" +
+	"\trebalance\x18\x03 \x01(\bR\trebalance\"\x8b\x01\n" +
+	"\x10ActivationReport\x12+\n" +
+	"\x04user\x18\x01 \x01(\v2\x17.reviewassigner.v1.UserR\x04user\x12J\n" +
+	"\x10received_reviews\x18\x02 \x03(\v2\x1f.reviewassigner.v1.ReassignmentR\x0freceivedReviews\"\x99\x01\n" +
+	"\x19ActivateTeamUsersResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x1c\n" +
+	"\trebalance\x18\x02 \x01(\bR\trebalance\x12A\n" +
+	"\tactivated\x18\x03 \x03(\v2#.reviewassigner.v1.ActivationReportR\tactivated\"/\n" +
+	"\x14GetIdentitiesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"m\n" +
+	"\x15GetIdentitiesResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\n" +
+	"identities\x18\x02 \x03(\v2\x1b.reviewassigner.v1.IdentityR\n" +
+	"identities\"L\n" +
+	"\x15DeleteIdentityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"\x18\n" +
+	"\x16DeleteIdentityResponse\"T\n" +
+	"\x15LookupIdentityRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\"\x9b\x01\n" +
+	"\x0fCreatePRRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"u\n" +
+	"\x10CreatePRResponse\x12.\n" +
+	"\x02pr\x18\x01 \x01(\v2\x1e.reviewassigner.v1.PullRequestR\x02pr\x121\n" +
+	"\x04plan\x18\x02 \x01(\v2\x1d.reviewassigner.v1.ChangePlanR\x04plan\"8\n" +
+	"\x0eMergePRRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"z\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x9e\x01\n" +
+	"\x18ReassignReviewerResponse\x12.\n" +
+	"\x02pr\x18\x01 \x01(\v2\x1e.reviewassigner.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\x121\n" +
+	"\x04plan\x18\x03 \x01(\v2\x1d.reviewassigner.v1.ChangePlanR\x04plan\"\x11\n" +
+	"\x0fGetStatsRequest\"\x8e\x03\n" +
+	"\x05Stats\x12\x1b\n" +
+	"\ttotal_prs\x18\x01 \x01(\x05R\btotalPrs\x12M\n" +
+	"\rprs_by_status\x18\x02 \x03(\v2).reviewassigner.v1.Stats.PrsByStatusEntryR\vprsByStatus\x12S\n" +
+	"\x0freviews_by_user\x18\x03 \x03(\v2+.reviewassigner.v1.Stats.ReviewsByUserEntryR\rreviewsByUser\x12!\n" +
+	"\factive_users\x18\x04 \x01(\x05R\vactiveUsers\x12\x1f\n" +
+	"\vtotal_teams\x18\x05 \x01(\x05R\n" +
+	"totalTeams\x1a>\n" +
+	"\x10PrsByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a@\n" +
+	"\x12ReviewsByUserEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x012\xc7\r\n" +
+	"\x0eReviewAssigner\x12K\n" +
+	"\n" +
+	"CreateTeam\x12$.reviewassigner.v1.CreateTeamRequest\x1a\x17.reviewassigner.v1.Team\x12E\n" +
+	"\aGetTeam\x12!.reviewassigner.v1.GetTeamRequest\x1a\x17.reviewassigner.v1.Team\x12V\n" +
+	"\tListTeams\x12#.reviewassigner.v1.ListTeamsRequest\x1a$.reviewassigner.v1.ListTeamsResponse\x12_\n" +
+	"\fSetTeamRules\x12&.reviewassigner.v1.SetTeamRulesRequest\x1a'.reviewassigner.v1.SetTeamRulesResponse\x12M\n" +
+	"\tSyncTeams\x12#.reviewassigner.v1.SyncTeamsRequest\x1a\x1b.reviewassigner.v1.SyncPlan\x12b\n" +
+	"\rSetUserActive\x12'.reviewassigner.v1.SetUserActiveRequest\x1a(.reviewassigner.v1.SetUserActiveResponse\x12L\n" +
+	"\aGetUser\x12!.reviewassigner.v1.GetUserRequest\x1a\x1e.reviewassigner.v1.UserDetails\x12\\\n" +
+	"\vSearchUsers\x12%.reviewassigner.v1.SearchUsersRequest\x1a&.reviewassigner.v1.SearchUsersResponse\x12e\n" +
+	"\x0eGetUserReviews\x12(.reviewassigner.v1.GetUserReviewsRequest\x1a).reviewassigner.v1.GetUserReviewsResponse\x12k\n" +
+	"\x13DeactivateTeamUsers\x12-.reviewassigner.v1.DeactivateTeamUsersRequest\x1a%.reviewassigner.v1.DeactivationReport\x12n\n" +
+	"\x11ActivateTeamUsers\x12+.reviewassigner.v1.ActivateTeamUsersRequest\x1a,.reviewassigner.v1.ActivateTeamUsersResponse\x12G\n" +
+	"\vSetIdentity\x12\x1b.reviewassigner.v1.Identity\x1a\x1b.reviewassigner.v1.Identity\x12b\n" +
+	"\rGetIdentities\x12'.reviewassigner.v1.GetIdentitiesRequest\x1a(.reviewassigner.v1.GetIdentitiesResponse\x12e\n" +
+	"\x0eDeleteIdentity\x12(.reviewassigner.v1.DeleteIdentityRequest\x1a).reviewassigner.v1.DeleteIdentityResponse\x12W\n" +
+	"\x0eLookupIdentity\x12(.reviewassigner.v1.LookupIdentityRequest\x1a\x1b.reviewassigner.v1.Identity\x12S\n" +
+	"\bCreatePR\x12\".reviewassigner.v1.CreatePRRequest\x1a#.reviewassigner.v1.CreatePRResponse\x12L\n" +
+	"\aMergePR\x12!.reviewassigner.v1.MergePRRequest\x1a\x1e.reviewassigner.v1.PullRequest\x12k\n" +
+	"\x10ReassignReviewer\x12*.reviewassigner.v1.ReassignReviewerRequest\x1a+.reviewassigner.v1.ReassignReviewerResponse\x12H\n" +
+	"\bGetStats\x12\".reviewassigner.v1.GetStatsRequest\x1a\x18.reviewassigner.v1.StatsBVZTgithub.com/2Empty/review-assigner/internal/grpcapi/reviewassignerv1;reviewassignerv1b\x06proto3"
+
+var (
+	file_reviewassigner_v1_reviewassigner_proto_rawDescOnce sync.Once
+	file_reviewassigner_v1_reviewassigner_proto_rawDescData []byte
+)
+
+func file_reviewassigner_v1_reviewassigner_proto_rawDescGZIP() []byte {
+	file_reviewassigner_v1_reviewassigner_proto_rawDescOnce.Do(func() {
+		file_reviewassigner_v1_reviewassigner_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reviewassigner_v1_reviewassigner_proto_rawDesc), len(file_reviewassigner_v1_reviewassigner_proto_rawDesc)))
+	})
+	return file_reviewassigner_v1_reviewassigner_proto_rawDescData
+}
+
+var file_reviewassigner_v1_reviewassigner_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_reviewassigner_v1_reviewassigner_proto_goTypes = []any{
+	(*User)(nil),                       // 0: reviewassigner.v1.User
+	(*UserDetails)(nil),                // 1: reviewassigner.v1.UserDetails
+	(*Identity)(nil),                   // 2: reviewassigner.v1.Identity
+	(*TeamMember)(nil),                 // 3: reviewassigner.v1.TeamMember
+	(*ReviewRule)(nil),                 // 4: reviewassigner.v1.ReviewRule
+	(*RuleViolation)(nil),              // 5: reviewassigner.v1.RuleViolation
+	(*Team)(nil),                       // 6: reviewassigner.v1.Team
+	(*TeamSummary)(nil),                // 7: reviewassigner.v1.TeamSummary
+	(*PullRequest)(nil),                // 8: reviewassigner.v1.PullRequest
+	(*PullRequestShort)(nil),           // 9: reviewassigner.v1.PullRequestShort
+	(*Reassignment)(nil),               // 10: reviewassigner.v1.Reassignment
+	(*ChangePlan)(nil),                 // 11: reviewassigner.v1.ChangePlan
+	(*CreateTeamRequest)(nil),          // 12: reviewassigner.v1.CreateTeamRequest
+	(*GetTeamRequest)(nil),             // 13: reviewassigner.v1.GetTeamRequest
+	(*ListTeamsRequest)(nil),           // 14: reviewassigner.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),          // 15: reviewassigner.v1.ListTeamsResponse
+	(*SetTeamRulesRequest)(nil),        // 16: reviewassigner.v1.SetTeamRulesRequest
+	(*SetTeamRulesResponse)(nil),       // 17: reviewassigner.v1.SetTeamRulesResponse
+	(*MemberSpec)(nil),                 // 18: reviewassigner.v1.MemberSpec
+	(*TeamSpec)(nil),                   // 19: reviewassigner.v1.TeamSpec
+	(*SyncTeamsRequest)(nil),           // 20: reviewassigner.v1.SyncTeamsRequest
+	(*SyncChange)(nil),                 // 21: reviewassigner.v1.SyncChange
+	(*SyncPlan)(nil),                   // 22: reviewassigner.v1.SyncPlan
+	(*SetUserActiveRequest)(nil),       // 23: reviewassigner.v1.SetUserActiveRequest
+	(*SetUserActiveResponse)(nil),      // 24: reviewassigner.v1.SetUserActiveResponse
+	(*GetUserRequest)(nil),             // 25: reviewassigner.v1.GetUserRequest
+	(*SearchUsersRequest)(nil),         // 26: reviewassigner.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),        // 27: reviewassigner.v1.SearchUsersResponse
+	(*GetUserReviewsRequest)(nil),      // 28: reviewassigner.v1.GetUserReviewsRequest
+	(*GetUserReviewsResponse)(nil),     // 29: reviewassigner.v1.GetUserReviewsResponse
+	(*DeactivateTeamUsersRequest)(nil), // 30: reviewassigner.v1.DeactivateTeamUsersRequest
+	(*UserDeactivationResult)(nil),     // 31: reviewassigner.v1.UserDeactivationResult
+	(*PullRequestOutcome)(nil),         // 32: reviewassigner.v1.PullRequestOutcome
+	(*DeactivationReport)(nil),         // 33: reviewassigner.v1.DeactivationReport
+	(*ActivateTeamUsersRequest)(nil),   // 34: reviewassigner.v1.ActivateTeamUsersRequest
+	(*ActivationReport)(nil),           // 35: reviewassigner.v1.ActivationReport
+	(*ActivateTeamUsersResponse)(nil),  // 36: reviewassigner.v1.ActivateTeamUsersResponse
+	(*GetIdentitiesRequest)(nil),       // 37: reviewassigner.v1.GetIdentitiesRequest
+	(*GetIdentitiesResponse)(nil),      // 38: reviewassigner.v1.GetIdentitiesResponse
+	(*DeleteIdentityRequest)(nil),      // 39: reviewassigner.v1.DeleteIdentityRequest
+	(*DeleteIdentityResponse)(nil),     // 40: reviewassigner.v1.DeleteIdentityResponse
+	(*LookupIdentityRequest)(nil),      // 41: reviewassigner.v1.LookupIdentityRequest
+	(*CreatePRRequest)(nil),            // 42: reviewassigner.v1.CreatePRRequest
+	(*CreatePRResponse)(nil),           // 43: reviewassigner.v1.CreatePRResponse
+	(*MergePRRequest)(nil),             // 44: reviewassigner.v1.MergePRRequest
+	(*ReassignReviewerRequest)(nil),    // 45: reviewassigner.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),   // 46: reviewassigner.v1.ReassignReviewerResponse
+	(*GetStatsRequest)(nil),            // 47: reviewassigner.v1.GetStatsRequest
+	(*Stats)(nil),                      // 48: reviewassigner.v1.Stats
+	nil,                                // 49: reviewassigner.v1.Stats.PrsByStatusEntry
+	nil,                                // 50: reviewassigner.v1.Stats.ReviewsByUserEntry
+	(*timestamppb.Timestamp)(nil),      // 51: google.protobuf.Timestamp
+}
+var file_reviewassigner_v1_reviewassigner_proto_depIdxs = []int32{
+	0,  // 0: reviewassigner.v1.UserDetails.user:type_name -> reviewassigner.v1.User
+	2,  // 1: reviewassigner.v1.TeamMember.identities:type_name -> reviewassigner.v1.Identity
+	3,  // 2: reviewassigner.v1.Team.members:type_name -> reviewassigner.v1.TeamMember
+	4,  // 3: reviewassigner.v1.Team.review_rules:type_name -> reviewassigner.v1.ReviewRule
+	51, // 4: reviewassigner.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	51, // 5: reviewassigner.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	5,  // 6: reviewassigner.v1.PullRequest.rule_violations:type_name -> reviewassigner.v1.RuleViolation
+	10, // 7: reviewassigner.v1.ChangePlan.reassignments:type_name -> reviewassigner.v1.Reassignment
+	6,  // 8: reviewassigner.v1.CreateTeamRequest.team:type_name -> reviewassigner.v1.Team
+	7,  // 9: reviewassigner.v1.ListTeamsResponse.teams:type_name -> reviewassigner.v1.TeamSummary
+	4,  // 10: reviewassigner.v1.SetTeamRulesRequest.review_rules:type_name -> reviewassigner.v1.ReviewRule
+	4,  // 11: reviewassigner.v1.SetTeamRulesResponse.review_rules:type_name -> reviewassigner.v1.ReviewRule
+	2,  // 12: reviewassigner.v1.MemberSpec.identities:type_name -> reviewassigner.v1.Identity
+	18, // 13: reviewassigner.v1.TeamSpec.members:type_name -> reviewassigner.v1.MemberSpec
+	4,  // 14: reviewassigner.v1.TeamSpec.review_rules:type_name -> reviewassigner.v1.ReviewRule
+	19, // 15: reviewassigner.v1.SyncTeamsRequest.teams:type_name -> reviewassigner.v1.TeamSpec
+	21, // 16: reviewassigner.v1.SyncPlan.changes:type_name -> reviewassigner.v1.SyncChange
+	10, // 17: reviewassigner.v1.SyncPlan.reassignments:type_name -> reviewassigner.v1.Reassignment
+	0,  // 18: reviewassigner.v1.SetUserActiveResponse.user:type_name -> reviewassigner.v1.User
+	11, // 19: reviewassigner.v1.SetUserActiveResponse.plan:type_name -> reviewassigner.v1.ChangePlan
+	1,  // 20: reviewassigner.v1.SearchUsersResponse.users:type_name -> reviewassigner.v1.UserDetails
+	9,  // 21: reviewassigner.v1.GetUserReviewsResponse.pull_requests:type_name -> reviewassigner.v1.PullRequestShort
+	0,  // 22: reviewassigner.v1.UserDeactivationResult.user:type_name -> reviewassigner.v1.User
+	31, // 23: reviewassigner.v1.DeactivationReport.users:type_name -> reviewassigner.v1.UserDeactivationResult
+	32, // 24: reviewassigner.v1.DeactivationReport.pull_requests:type_name -> reviewassigner.v1.PullRequestOutcome
+	0,  // 25: reviewassigner.v1.ActivationReport.user:type_name -> reviewassigner.v1.User
+	10, // 26: reviewassigner.v1.ActivationReport.received_reviews:type_name -> reviewassigner.v1.Reassignment
+	35, // 27: reviewassigner.v1.ActivateTeamUsersResponse.activated:type_name -> reviewassigner.v1.ActivationReport
+	2,  // 28: reviewassigner.v1.GetIdentitiesResponse.identities:type_name -> reviewassigner.v1.Identity
+	8,  // 29: reviewassigner.v1.CreatePRResponse.pr:type_name -> reviewassigner.v1.PullRequest
+	11, // 30: reviewassigner.v1.CreatePRResponse.plan:type_name -> reviewassigner.v1.ChangePlan
+	8,  // 31: reviewassigner.v1.ReassignReviewerResponse.pr:type_name -> reviewassigner.v1.PullRequest
+	11, // 32: reviewassigner.v1.ReassignReviewerResponse.plan:type_name -> reviewassigner.v1.ChangePlan
+	49, // 33: reviewassigner.v1.Stats.prs_by_status:type_name -> reviewassigner.v1.Stats.PrsByStatusEntry
+	50, // 34: reviewassigner.v1.Stats.reviews_by_user:type_name -> reviewassigner.v1.Stats.ReviewsByUserEntry
+	12, // 35: reviewassigner.v1.ReviewAssigner.CreateTeam:input_type -> reviewassigner.v1.CreateTeamRequest
+	13, // 36: reviewassigner.v1.ReviewAssigner.GetTeam:input_type -> reviewassigner.v1.GetTeamRequest
+	14, // 37: reviewassigner.v1.ReviewAssigner.ListTeams:input_type -> reviewassigner.v1.ListTeamsRequest
+	16, // 38: reviewassigner.v1.ReviewAssigner.SetTeamRules:input_type -> reviewassigner.v1.SetTeamRulesRequest
+	20, // 39: reviewassigner.v1.ReviewAssigner.SyncTeams:input_type -> reviewassigner.v1.SyncTeamsRequest
+	23, // 40: reviewassigner.v1.ReviewAssigner.SetUserActive:input_type -> reviewassigner.v1.SetUserActiveRequest
+	25, // 41: reviewassigner.v1.ReviewAssigner.GetUser:input_type -> reviewassigner.v1.GetUserRequest
+	26, // 42: reviewassigner.v1.ReviewAssigner.SearchUsers:input_type -> reviewassigner.v1.SearchUsersRequest
+	28, // 43: reviewassigner.v1.ReviewAssigner.GetUserReviews:input_type -> reviewassigner.v1.GetUserReviewsRequest
+	30, // 44: reviewassigner.v1.ReviewAssigner.DeactivateTeamUsers:input_type -> reviewassigner.v1.DeactivateTeamUsersRequest
+	34, // 45: reviewassigner.v1.ReviewAssigner.ActivateTeamUsers:input_type -> reviewassigner.v1.ActivateTeamUsersRequest
+	2,  // 46: reviewassigner.v1.ReviewAssigner.SetIdentity:input_type -> reviewassigner.v1.Identity
+	37, // 47: reviewassigner.v1.ReviewAssigner.GetIdentities:input_type -> reviewassigner.v1.GetIdentitiesRequest
+	39, // 48: reviewassigner.v1.ReviewAssigner.DeleteIdentity:input_type -> reviewassigner.v1.DeleteIdentityRequest
+	41, // 49: reviewassigner.v1.ReviewAssigner.LookupIdentity:input_type -> reviewassigner.v1.LookupIdentityRequest
+	42, // 50: reviewassigner.v1.ReviewAssigner.CreatePR:input_type -> reviewassigner.v1.CreatePRRequest
+	44, // 51: reviewassigner.v1.ReviewAssigner.MergePR:input_type -> reviewassigner.v1.MergePRRequest
+	45, // 52: reviewassigner.v1.ReviewAssigner.ReassignReviewer:input_type -> reviewassigner.v1.ReassignReviewerRequest
+	47, // 53: reviewassigner.v1.ReviewAssigner.GetStats:input_type -> reviewassigner.v1.GetStatsRequest
+	6,  // 54: reviewassigner.v1.ReviewAssigner.CreateTeam:output_type -> reviewassigner.v1.Team
+	6,  // 55: reviewassigner.v1.ReviewAssigner.GetTeam:output_type -> reviewassigner.v1.Team
+	15, // 56: reviewassigner.v1.ReviewAssigner.ListTeams:output_type -> reviewassigner.v1.ListTeamsResponse
+	17, // 57: reviewassigner.v1.ReviewAssigner.SetTeamRules:output_type -> reviewassigner.v1.SetTeamRulesResponse
+	22, // 58: reviewassigner.v1.ReviewAssigner.SyncTeams:output_type -> reviewassigner.v1.SyncPlan
+	24, // 59: reviewassigner.v1.ReviewAssigner.SetUserActive:output_type -> reviewassigner.v1.SetUserActiveResponse
+	1,  // 60: reviewassigner.v1.ReviewAssigner.GetUser:output_type -> reviewassigner.v1.UserDetails
+	27, // 61: reviewassigner.v1.ReviewAssigner.SearchUsers:output_type -> reviewassigner.v1.SearchUsersResponse
+	29, // 62: reviewassigner.v1.ReviewAssigner.GetUserReviews:output_type -> reviewassigner.v1.GetUserReviewsResponse
+	33, // 63: reviewassigner.v1.ReviewAssigner.DeactivateTeamUsers:output_type -> reviewassigner.v1.DeactivationReport
+	36, // 64: reviewassigner.v1.ReviewAssigner.ActivateTeamUsers:output_type -> reviewassigner.v1.ActivateTeamUsersResponse
+	2,  // 65: reviewassigner.v1.ReviewAssigner.SetIdentity:output_type -> reviewassigner.v1.Identity
+	38, // 66: reviewassigner.v1.ReviewAssigner.GetIdentities:output_type -> reviewassigner.v1.GetIdentitiesResponse
+	40, // 67: reviewassigner.v1.ReviewAssigner.DeleteIdentity:output_type -> reviewassigner.v1.DeleteIdentityResponse
+	2,  // 68: reviewassigner.v1.ReviewAssigner.LookupIdentity:output_type -> reviewassigner.v1.Identity
+	43, // 69: reviewassigner.v1.ReviewAssigner.CreatePR:output_type -> reviewassigner.v1.CreatePRResponse
+	8,  // 70: reviewassigner.v1.ReviewAssigner.MergePR:output_type -> reviewassigner.v1.PullRequest
+	46, // 71: reviewassigner.v1.ReviewAssigner.ReassignReviewer:output_type -> reviewassigner.v1.ReassignReviewerResponse
+	48, // 72: reviewassigner.v1.ReviewAssigner.GetStats:output_type -> reviewassigner.v1.Stats
+	54, // [54:73] is the sub-list for method output_type
+	35, // [35:54] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_reviewassigner_v1_reviewassigner_proto_init() }
+func file_reviewassigner_v1_reviewassigner_proto_init() {
+	if File_reviewassigner_v1_reviewassigner_proto != nil {
+		return
+	}
+	file_reviewassigner_v1_reviewassigner_proto_msgTypes[18].OneofWrappers = []any{}
+	file_reviewassigner_v1_reviewassigner_proto_msgTypes[26].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviewassigner_v1_reviewassigner_proto_rawDesc), len(file_reviewassigner_v1_reviewassigner_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   51,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reviewassigner_v1_reviewassigner_proto_goTypes,
+		DependencyIndexes: file_reviewassigner_v1_reviewassigner_proto_depIdxs,
+		MessageInfos:      file_reviewassigner_v1_reviewassigner_proto_msgTypes,
+	}.Build()
+	File_reviewassigner_v1_reviewassigner_proto = out.File
+	file_reviewassigner_v1_reviewassigner_proto_goTypes = nil
+	file_reviewassigner_v1_reviewassigner_proto_depIdxs = nil
+}
@@ -0,0 +1,817 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: reviewassigner/v1/reviewassigner.proto
+
+// API сервиса назначения ревьюеров. Повторяет операции HTTP-ручек.
+
+package reviewassignerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReviewAssigner_CreateTeam_FullMethodName          = "/reviewassigner.v1.ReviewAssigner/CreateTeam"
+	ReviewAssigner_GetTeam_FullMethodName             = "/reviewassigner.v1.ReviewAssigner/GetTeam"
+	ReviewAssigner_ListTeams_FullMethodName           = "/reviewassigner.v1.ReviewAssigner/ListTeams"
+	ReviewAssigner_SetTeamRules_FullMethodName        = "/reviewassigner.v1.ReviewAssigner/SetTeamRules"
+	ReviewAssigner_SyncTeams_FullMethodName           = "/reviewassigner.v1.ReviewAssigner/SyncTeams"
+	ReviewAssigner_SetUserActive_FullMethodName       = "/reviewassigner.v1.ReviewAssigner/SetUserActive"
+	ReviewAssigner_GetUser_FullMethodName             = "/reviewassigner.v1.ReviewAssigner/GetUser"
+	ReviewAssigner_SearchUsers_FullMethodName         = "/reviewassigner.v1.ReviewAssigner/SearchUsers"
+	ReviewAssigner_GetUserReviews_FullMethodName      = "/reviewassigner.v1.ReviewAssigner/GetUserReviews"
+	ReviewAssigner_DeactivateTeamUsers_FullMethodName = "/reviewassigner.v1.ReviewAssigner/DeactivateTeamUsers"
+	ReviewAssigner_ActivateTeamUsers_FullMethodName   = "/reviewassigner.v1.ReviewAssigner/ActivateTeamUsers"
+	ReviewAssigner_SetIdentity_FullMethodName         = "/reviewassigner.v1.ReviewAssigner/SetIdentity"
+	ReviewAssigner_GetIdentities_FullMethodName       = "/reviewassigner.v1.ReviewAssigner/GetIdentities"
+	ReviewAssigner_DeleteIdentity_FullMethodName      = "/reviewassigner.v1.ReviewAssigner/DeleteIdentity"
+	ReviewAssigner_LookupIdentity_FullMethodName      = "/reviewassigner.v1.ReviewAssigner/LookupIdentity"
+	ReviewAssigner_CreatePR_FullMethodName            = "/reviewassigner.v1.ReviewAssigner/CreatePR"
+	ReviewAssigner_MergePR_FullMethodName             = "/reviewassigner.v1.ReviewAssigner/MergePR"
+	ReviewAssigner_ReassignReviewer_FullMethodName    = "/reviewassigner.v1.ReviewAssigner/ReassignReviewer"
+	ReviewAssigner_GetStats_FullMethodName            = "/reviewassigner.v1.ReviewAssigner/GetStats"
+)
+
+// ReviewAssignerClient is the client API for ReviewAssigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewAssignerClient interface {
+	// Команды
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	SetTeamRules(ctx context.Context, in *SetTeamRulesRequest, opts ...grpc.CallOption) (*SetTeamRulesResponse, error)
+	SyncTeams(ctx context.Context, in *SyncTeamsRequest, opts ...grpc.CallOption) (*SyncPlan, error)
+	// Пользователи
+	SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*SetUserActiveResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserDetails, error)
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error)
+	DeactivateTeamUsers(ctx context.Context, in *DeactivateTeamUsersRequest, opts ...grpc.CallOption) (*DeactivationReport, error)
+	ActivateTeamUsers(ctx context.Context, in *ActivateTeamUsersRequest, opts ...grpc.CallOption) (*ActivateTeamUsersResponse, error)
+	// Внешние учётные записи
+	SetIdentity(ctx context.Context, in *Identity, opts ...grpc.CallOption) (*Identity, error)
+	GetIdentities(ctx context.Context, in *GetIdentitiesRequest, opts ...grpc.CallOption) (*GetIdentitiesResponse, error)
+	DeleteIdentity(ctx context.Context, in *DeleteIdentityRequest, opts ...grpc.CallOption) (*DeleteIdentityResponse, error)
+	LookupIdentity(ctx context.Context, in *LookupIdentityRequest, opts ...grpc.CallOption) (*Identity, error)
+	// Pull request'ы
+	CreatePR(ctx context.Context, in *CreatePRRequest, opts ...grpc.CallOption) (*CreatePRResponse, error)
+	MergePR(ctx context.Context, in *MergePRRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error)
+	// Статистика
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*Stats, error)
+}
+
+type reviewAssignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewAssignerClient(cc grpc.ClientConnInterface) ReviewAssignerClient {
+	return &reviewAssignerClient{cc}
+}
+
+func (c *reviewAssignerClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ReviewAssigner_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) SetTeamRules(ctx context.Context, in *SetTeamRulesRequest, opts ...grpc.CallOption) (*SetTeamRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamRulesResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_SetTeamRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) SyncTeams(ctx context.Context, in *SyncTeamsRequest, opts ...grpc.CallOption) (*SyncPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncPlan)
+	err := c.cc.Invoke(ctx, ReviewAssigner_SyncTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*SetUserActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserActiveResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_SetUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDetails)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) DeactivateTeamUsers(ctx context.Context, in *DeactivateTeamUsersRequest, opts ...grpc.CallOption) (*DeactivationReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivationReport)
+	err := c.cc.Invoke(ctx, ReviewAssigner_DeactivateTeamUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) ActivateTeamUsers(ctx context.Context, in *ActivateTeamUsersRequest, opts ...grpc.CallOption) (*ActivateTeamUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateTeamUsersResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_ActivateTeamUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) SetIdentity(ctx context.Context, in *Identity, opts ...grpc.CallOption) (*Identity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Identity)
+	err := c.cc.Invoke(ctx, ReviewAssigner_SetIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetIdentities(ctx context.Context, in *GetIdentitiesRequest, opts ...grpc.CallOption) (*GetIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIdentitiesResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) DeleteIdentity(ctx context.Context, in *DeleteIdentityRequest, opts ...grpc.CallOption) (*DeleteIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteIdentityResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_DeleteIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) LookupIdentity(ctx context.Context, in *LookupIdentityRequest, opts ...grpc.CallOption) (*Identity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Identity)
+	err := c.cc.Invoke(ctx, ReviewAssigner_LookupIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) CreatePR(ctx context.Context, in *CreatePRRequest, opts ...grpc.CallOption) (*CreatePRResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePRResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_CreatePR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) MergePR(ctx context.Context, in *MergePRRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, ReviewAssigner_MergePR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignReviewerResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*Stats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewAssignerServer is the server API for ReviewAssigner service.
+// All implementations must embed UnimplementedReviewAssignerServer
+// for forward compatibility.
+type ReviewAssignerServer interface {
+	// Команды
+	CreateTeam(context.Context, *CreateTeamRequest) (*Team, error)
+	GetTeam(context.Context, *GetTeamRequest) (*Team, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	SetTeamRules(context.Context, *SetTeamRulesRequest) (*SetTeamRulesResponse, error)
+	SyncTeams(context.Context, *SyncTeamsRequest) (*SyncPlan, error)
+	// Пользователи
+	SetUserActive(context.Context, *SetUserActiveRequest) (*SetUserActiveResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*UserDetails, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error)
+	DeactivateTeamUsers(context.Context, *DeactivateTeamUsersRequest) (*DeactivationReport, error)
+	ActivateTeamUsers(context.Context, *ActivateTeamUsersRequest) (*ActivateTeamUsersResponse, error)
+	// Внешние учётные записи
+	SetIdentity(context.Context, *Identity) (*Identity, error)
+	GetIdentities(context.Context, *GetIdentitiesRequest) (*GetIdentitiesResponse, error)
+	DeleteIdentity(context.Context, *DeleteIdentityRequest) (*DeleteIdentityResponse, error)
+	LookupIdentity(context.Context, *LookupIdentityRequest) (*Identity, error)
+	// Pull request'ы
+	CreatePR(context.Context, *CreatePRRequest) (*CreatePRResponse, error)
+	MergePR(context.Context, *MergePRRequest) (*PullRequest, error)
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error)
+	// Статистика
+	GetStats(context.Context, *GetStatsRequest) (*Stats, error)
+	mustEmbedUnimplementedReviewAssignerServer()
+}
+
+// UnimplementedReviewAssignerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewAssignerServer struct{}
+
+func (UnimplementedReviewAssignerServer) CreateTeam(context.Context, *CreateTeamRequest) (*Team, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetTeam(context.Context, *GetTeamRequest) (*Team, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedReviewAssignerServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedReviewAssignerServer) SetTeamRules(context.Context, *SetTeamRulesRequest) (*SetTeamRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTeamRules not implemented")
+}
+func (UnimplementedReviewAssignerServer) SyncTeams(context.Context, *SyncTeamsRequest) (*SyncPlan, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncTeams not implemented")
+}
+func (UnimplementedReviewAssignerServer) SetUserActive(context.Context, *SetUserActiveRequest) (*SetUserActiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserActive not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetUser(context.Context, *GetUserRequest) (*UserDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedReviewAssignerServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserReviews not implemented")
+}
+func (UnimplementedReviewAssignerServer) DeactivateTeamUsers(context.Context, *DeactivateTeamUsersRequest) (*DeactivationReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateTeamUsers not implemented")
+}
+func (UnimplementedReviewAssignerServer) ActivateTeamUsers(context.Context, *ActivateTeamUsersRequest) (*ActivateTeamUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateTeamUsers not implemented")
+}
+func (UnimplementedReviewAssignerServer) SetIdentity(context.Context, *Identity) (*Identity, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetIdentity not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetIdentities(context.Context, *GetIdentitiesRequest) (*GetIdentitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIdentities not implemented")
+}
+func (UnimplementedReviewAssignerServer) DeleteIdentity(context.Context, *DeleteIdentityRequest) (*DeleteIdentityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteIdentity not implemented")
+}
+func (UnimplementedReviewAssignerServer) LookupIdentity(context.Context, *LookupIdentityRequest) (*Identity, error) {
+	return nil, status.Error(codes.Unimplemented, "method LookupIdentity not implemented")
+}
+func (UnimplementedReviewAssignerServer) CreatePR(context.Context, *CreatePRRequest) (*CreatePRResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePR not implemented")
+}
+func (UnimplementedReviewAssignerServer) MergePR(context.Context, *MergePRRequest) (*PullRequest, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergePR not implemented")
+}
+func (UnimplementedReviewAssignerServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetStats(context.Context, *GetStatsRequest) (*Stats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedReviewAssignerServer) mustEmbedUnimplementedReviewAssignerServer() {}
+func (UnimplementedReviewAssignerServer) testEmbeddedByValue()                        {}
+
+// UnsafeReviewAssignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewAssignerServer will
+// result in compilation errors.
+type UnsafeReviewAssignerServer interface {
+	mustEmbedUnimplementedReviewAssignerServer()
+}
+
+func RegisterReviewAssignerServer(s grpc.ServiceRegistrar, srv ReviewAssignerServer) {
+	// If the following call panics, it indicates UnimplementedReviewAssignerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewAssigner_ServiceDesc, srv)
+}
+
+func _ReviewAssigner_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_SetTeamRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).SetTeamRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_SetTeamRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).SetTeamRules(ctx, req.(*SetTeamRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_SyncTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).SyncTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_SyncTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).SyncTeams(ctx, req.(*SyncTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_SetUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).SetUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_SetUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).SetUserActive(ctx, req.(*SetUserActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetUserReviews(ctx, req.(*GetUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_DeactivateTeamUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateTeamUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).DeactivateTeamUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_DeactivateTeamUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).DeactivateTeamUsers(ctx, req.(*DeactivateTeamUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_ActivateTeamUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateTeamUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).ActivateTeamUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_ActivateTeamUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).ActivateTeamUsers(ctx, req.(*ActivateTeamUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_SetIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).SetIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_SetIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).SetIdentity(ctx, req.(*Identity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetIdentities(ctx, req.(*GetIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_DeleteIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).DeleteIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_DeleteIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).DeleteIdentity(ctx, req.(*DeleteIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_LookupIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).LookupIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_LookupIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).LookupIdentity(ctx, req.(*LookupIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_CreatePR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).CreatePR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_CreatePR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).CreatePR(ctx, req.(*CreatePRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_MergePR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).MergePR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_MergePR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).MergePR(ctx, req.(*MergePRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewAssigner_ServiceDesc is the grpc.ServiceDesc for ReviewAssigner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewAssigner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reviewassigner.v1.ReviewAssigner",
+	HandlerType: (*ReviewAssignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeam",
+			Handler:    _ReviewAssigner_CreateTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _ReviewAssigner_GetTeam_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _ReviewAssigner_ListTeams_Handler,
+		},
+		{
+			MethodName: "SetTeamRules",
+			Handler:    _ReviewAssigner_SetTeamRules_Handler,
+		},
+		{
+			MethodName: "SyncTeams",
+			Handler:    _ReviewAssigner_SyncTeams_Handler,
+		},
+		{
+			MethodName: "SetUserActive",
+			Handler:    _ReviewAssigner_SetUserActive_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _ReviewAssigner_GetUser_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _ReviewAssigner_SearchUsers_Handler,
+		},
+		{
+			MethodName: "GetUserReviews",
+			Handler:    _ReviewAssigner_GetUserReviews_Handler,
+		},
+		{
+			MethodName: "DeactivateTeamUsers",
+			Handler:    _ReviewAssigner_DeactivateTeamUsers_Handler,
+		},
+		{
+			MethodName: "ActivateTeamUsers",
+			Handler:    _ReviewAssigner_ActivateTeamUsers_Handler,
+		},
+		{
+			MethodName: "SetIdentity",
+			Handler:    _ReviewAssigner_SetIdentity_Handler,
+		},
+		{
+			MethodName: "GetIdentities",
+			Handler:    _ReviewAssigner_GetIdentities_Handler,
+		},
+		{
+			MethodName: "DeleteIdentity",
+			Handler:    _ReviewAssigner_DeleteIdentity_Handler,
+		},
+		{
+			MethodName: "LookupIdentity",
+			Handler:    _ReviewAssigner_LookupIdentity_Handler,
+		},
+		{
+			MethodName: "CreatePR",
+			Handler:    _ReviewAssigner_CreatePR_Handler,
+		},
+		{
+			MethodName: "MergePR",
+			Handler:    _ReviewAssigner_MergePR_Handler,
+		},
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _ReviewAssigner_ReassignReviewer_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _ReviewAssigner_GetStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reviewassigner/v1/reviewassigner.proto",
+}
@@ -0,0 +1,391 @@
+// Package grpcapi реализует gRPC API сервиса поверх того же store, что и HTTP-ручки.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/2Empty/review-assigner/internal/auth"
+	pb "github.com/2Empty/review-assigner/internal/grpcapi/reviewassignerv1"
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+	"google.golang.org/grpc"
+)
+
+// Ограничения постраничной выдачи совпадают с HTTP API.
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+)
+
+// Server реализует сервис ReviewAssigner.
+type Server struct {
+	pb.UnimplementedReviewAssignerServer
+	store *store.Store
+	authz *auth.Authorizer
+}
+
+// NewServer создает gRPC-сервер поверх store. Права на команды проверяются
+// для вызывающей стороны, сохранённой AuthInterceptor; без него всё разрешено.
+func NewServer(s *store.Store) *Server {
+	return &Server{store: s, authz: auth.NewAuthorizer(s)}
+}
+
+// Register регистрирует сервис на gRPC-сервере.
+func (s *Server) Register(g *grpc.Server) {
+	pb.RegisterReviewAssignerServer(g, s)
+}
+
+func pagination(limit, offset int32) (int, int, error) {
+	if limit < 0 {
+		return 0, 0, invalidArgument("limit must be a positive integer")
+	}
+	if offset < 0 {
+		return 0, 0, invalidArgument("offset must be a non-negative integer")
+	}
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	return min(int(limit), maxPageLimit), int(offset), nil
+}
+
+// CreateTeam создает команду с участниками.
+func (s *Server) CreateTeam(ctx context.Context, req *pb.CreateTeamRequest) (*pb.Team, error) {
+	team := teamFromPB(req.GetTeam())
+	if team.TeamName == "" || len(team.Members) == 0 {
+		return nil, invalidArgument("team_name and members are required")
+	}
+	for _, m := range team.Members {
+		if m.Role != "" && !models.IsValidRole(m.Role) {
+			return nil, invalidArgument("invalid role for user " + m.UserID)
+		}
+		if err := models.ValidateIdentities(m.Identities); err != nil {
+			return nil, invalidArgument(err.Error() + " for user " + m.UserID)
+		}
+	}
+	if err := models.ValidateRules(team.ReviewRules, s.store.ReviewersPerPR()); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+
+	if err := s.store.CreateTeam(ctx, team); err != nil {
+		return nil, toStatus(err)
+	}
+	return teamToPB(&team), nil
+}
+
+// GetTeam возвращает команду с участниками.
+func (s *Server) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+	team, err := s.store.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return teamToPB(team), nil
+}
+
+// ListTeams возвращает страницу команд.
+func (s *Server) ListTeams(ctx context.Context, req *pb.ListTeamsRequest) (*pb.ListTeamsResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	teams, total, err := s.store.ListTeams(ctx, limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListTeamsResponse{Total: int32(total), Limit: int32(limit), Offset: int32(offset)}
+	for _, t := range teams {
+		resp.Teams = append(resp.Teams, &pb.TeamSummary{
+			TeamName:     t.TeamName,
+			MembersCount: int32(t.MembersCount),
+			ActiveCount:  int32(t.ActiveCount),
+		})
+	}
+	return resp, nil
+}
+
+// SetTeamRules заменяет правила ролей команды.
+func (s *Server) SetTeamRules(ctx context.Context, req *pb.SetTeamRulesRequest) (*pb.SetTeamRulesResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+	rules := rulesFromPB(req.GetReviewRules())
+	if err := models.ValidateRules(rules, s.store.ReviewersPerPR()); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	if err := s.store.SetTeamRules(ctx, req.GetTeamName(), rules); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.SetTeamRulesResponse{TeamName: req.GetTeamName(), ReviewRules: req.GetReviewRules()}, nil
+}
+
+// SyncTeams приводит составы команд к описанию.
+func (s *Server) SyncTeams(ctx context.Context, req *pb.SyncTeamsRequest) (*pb.SyncPlan, error) {
+	spec := syncSpecFromPB(req)
+	if err := spec.Validate(s.store.ReviewersPerPR()); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	plan, err := s.store.SyncTeams(ctx, spec, req.GetDryRun())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return syncPlanToPB(plan), nil
+}
+
+// SetUserActive устанавливает флаг активности пользователя.
+func (s *Server) SetUserActive(ctx context.Context, req *pb.SetUserActiveRequest) (*pb.SetUserActiveResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+	if err := s.authz.AuthorizeUser(ctx, auth.FromContext(ctx), req.GetUserId()); err != nil {
+		return nil, toStatus(err)
+	}
+	user, plan, err := s.store.SetUserActive(ctx, req.GetUserId(), req.GetIsActive(), req.GetDryRun())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.SetUserActiveResponse{User: userToPB(user)}
+	if req.GetDryRun() {
+		resp.Plan = planToPB(plan)
+	}
+	return resp, nil
+}
+
+// GetUser возвращает пользователя с количеством открытых ревью.
+func (s *Server) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.UserDetails, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+	user, err := s.store.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return userDetailsToPB(user), nil
+}
+
+// SearchUsers ищет пользователей по префиксу user_id или username.
+func (s *Server) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	filter := models.UserSearchFilter{
+		Query:    strings.TrimSpace(req.GetQ()),
+		TeamName: req.GetTeamName(),
+		IsActive: req.IsActive,
+		Limit:    limit,
+		Offset:   offset,
+	}
+	users, total, err := s.store.SearchUsers(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.SearchUsersResponse{Total: int32(total), Limit: int32(limit), Offset: int32(offset)}
+	for i := range users {
+		resp.Users = append(resp.Users, userDetailsToPB(&users[i]))
+	}
+	return resp, nil
+}
+
+// GetUserReviews возвращает PR, где пользователь назначен ревьювером.
+func (s *Server) GetUserReviews(ctx context.Context, req *pb.GetUserReviewsRequest) (*pb.GetUserReviewsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+	prs, err := s.store.GetUserReviews(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetUserReviewsResponse{UserId: req.GetUserId()}
+	for _, pr := range prs {
+		resp.PullRequests = append(resp.PullRequests, &pb.PullRequestShort{
+			PullRequestId:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorID,
+			Status:          pr.Status,
+		})
+	}
+	return resp, nil
+}
+
+// DeactivateTeamUsers деактивирует нескольких пользователей одной команды.
+// При откате в режиме all_or_nothing возвращается Aborted, а отчёт с причинами
+// по пользователям и PR передаётся деталью статуса (DeactivationReport).
+func (s *Server) DeactivateTeamUsers(ctx context.Context, req *pb.DeactivateTeamUsersRequest) (*pb.DeactivationReport, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+	policy := req.GetPolicy()
+	if policy != "" && policy != models.PolicyAllOrNothing && policy != models.PolicyBestEffort {
+		return nil, invalidArgument("policy must be all_or_nothing or best_effort")
+	}
+
+	if err := s.authz.AuthorizeTeam(ctx, auth.FromContext(ctx), req.GetTeamName()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	report, err := s.store.DeactivateTeamUsers(ctx, req.GetTeamName(), req.GetUserIds(), policy, req.GetDryRun())
+	if err != nil {
+		if errors.Is(err, store.ErrDeactivationFailed) && report != nil {
+			return nil, withDetail(toStatus(err), deactivationReportToPB(report))
+		}
+		return nil, toStatus(err)
+	}
+	return deactivationReportToPB(report), nil
+}
+
+// ActivateTeamUsers активирует нескольких пользователей одной команды.
+func (s *Server) ActivateTeamUsers(ctx context.Context, req *pb.ActivateTeamUsersRequest) (*pb.ActivateTeamUsersResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+	if err := s.authz.AuthorizeTeam(ctx, auth.FromContext(ctx), req.GetTeamName()); err != nil {
+		return nil, toStatus(err)
+	}
+	report, err := s.store.ActivateTeamUsers(ctx, req.GetTeamName(), req.GetUserIds(), req.GetRebalance())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	// Ответ содержит только активированных пользователей; остальные статусы доступны в HTTP API
+	resp := &pb.ActivateTeamUsersResponse{TeamName: report.TeamName, Rebalance: report.Rebalance}
+	for _, u := range report.Users {
+		if u.Status != models.ActivationStatusActivated {
+			continue
+		}
+		resp.Activated = append(resp.Activated, &pb.ActivationReport{
+			User:            userToPB(u.User),
+			ReceivedReviews: reassignmentsToPB(u.ReceivedReviews),
+		})
+	}
+	return resp, nil
+}
+
+// SetIdentity привязывает внешнюю учётную запись к пользователю.
+func (s *Server) SetIdentity(ctx context.Context, req *pb.Identity) (*pb.Identity, error) {
+	identity := models.Identity{UserID: req.GetUserId(), Provider: req.GetProvider(), ExternalID: req.GetExternalId()}
+	if identity.UserID == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+	if err := models.ValidateIdentities([]models.Identity{identity}); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	if err := s.store.SetIdentity(ctx, identity); err != nil {
+		return nil, toStatus(err)
+	}
+	return req, nil
+}
+
+// GetIdentities возвращает внешние учётные записи пользователя.
+func (s *Server) GetIdentities(ctx context.Context, req *pb.GetIdentitiesRequest) (*pb.GetIdentitiesResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+	identities, err := s.store.ListIdentities(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.GetIdentitiesResponse{UserId: req.GetUserId(), Identities: identitiesToPB(identities)}, nil
+}
+
+// DeleteIdentity удаляет привязку пользователя к провайдеру.
+func (s *Server) DeleteIdentity(ctx context.Context, req *pb.DeleteIdentityRequest) (*pb.DeleteIdentityResponse, error) {
+	if req.GetUserId() == "" || req.GetProvider() == "" {
+		return nil, invalidArgument("user_id and provider are required")
+	}
+	if err := s.store.DeleteIdentity(ctx, req.GetUserId(), req.GetProvider()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteIdentityResponse{}, nil
+}
+
+// LookupIdentity находит пользователя по внешней учётной записи.
+func (s *Server) LookupIdentity(ctx context.Context, req *pb.LookupIdentityRequest) (*pb.Identity, error) {
+	if req.GetProvider() == "" || req.GetExternalId() == "" {
+		return nil, invalidArgument("provider and external_id are required")
+	}
+	identity, err := s.store.LookupIdentity(ctx, req.GetProvider(), req.GetExternalId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.Identity{UserId: identity.UserID, Provider: identity.Provider, ExternalId: identity.ExternalID}, nil
+}
+
+// CreatePR создает PR и назначает ревьюеров из команды автора.
+func (s *Server) CreatePR(ctx context.Context, req *pb.CreatePRRequest) (*pb.CreatePRResponse, error) {
+	if req.GetPullRequestId() == "" || req.GetPullRequestName() == "" || req.GetAuthorId() == "" {
+		return nil, invalidArgument("pull_request_id, pull_request_name and author_id are required")
+	}
+	pr, err := s.store.CreatePR(ctx, req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId(), req.GetDryRun())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.CreatePRResponse{Pr: prToPB(pr)}
+	if req.GetDryRun() {
+		assigned := make([]models.Reassignment, 0, len(pr.AssignedReviewers))
+		for _, id := range pr.AssignedReviewers {
+			assigned = append(assigned, models.Reassignment{PullRequestID: pr.PullRequestID, NewReviewerID: id})
+		}
+		resp.Plan = planToPB(models.NewChangePlan(true, pr.AssignedReviewers, assigned))
+	}
+	return resp, nil
+}
+
+// MergePR помечает PR как MERGED.
+func (s *Server) MergePR(ctx context.Context, req *pb.MergePRRequest) (*pb.PullRequest, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id is required")
+	}
+	pr, err := s.store.MergePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return prToPB(pr), nil
+}
+
+// ReassignReviewer переназначает ревьювера на другого участника его команды.
+func (s *Server) ReassignReviewer(ctx context.Context, req *pb.ReassignReviewerRequest) (*pb.ReassignReviewerResponse, error) {
+	if req.GetPullRequestId() == "" || req.GetOldUserId() == "" {
+		return nil, invalidArgument("pull_request_id and old_user_id are required")
+	}
+	if err := s.authz.AuthorizePR(ctx, auth.FromContext(ctx), req.GetPullRequestId()); err != nil {
+		return nil, toStatus(err)
+	}
+	pr, newUserID, err := s.store.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId(), req.GetDryRun())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ReassignReviewerResponse{Pr: prToPB(pr), ReplacedBy: newUserID}
+	if req.GetDryRun() {
+		resp.Plan = planToPB(models.NewChangePlan(true, []string{req.GetOldUserId(), newUserID}, []models.Reassignment{{
+			PullRequestID: pr.PullRequestID,
+			OldReviewerID: req.GetOldUserId(),
+			NewReviewerID: newUserID,
+		}}))
+	}
+	return resp, nil
+}
+
+// GetStats возвращает общую статистику сервиса.
+func (s *Server) GetStats(ctx context.Context, _ *pb.GetStatsRequest) (*pb.Stats, error) {
+	stats, err := s.store.GetStats(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.Stats{
+		TotalPrs:      int32(stats.TotalPRs),
+		PrsByStatus:   countsToPB(stats.PRsByStatus),
+		ReviewsByUser: countsToPB(stats.ReviewsByUser),
+		ActiveUsers:   int32(stats.ActiveUsers),
+		TotalTeams:    int32(stats.TotalTeams),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// ActivateTeamUsers активирует нескольких пользователей одной команды и,
+// при необходимости, передаёт им часть открытых ревью.
+func (h *Handler) ActivateTeamUsers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req models.ActivateTeamUsersRequest
+	if err := bindJSON(r, &req); err != nil {
+		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.TeamName == "" {
+		writeError(w, "INVALID_REQUEST", "team_name is required", http.StatusBadRequest)
+		return
+	}
+
+	if !h.authorizeTeam(w, r, req.TeamName) {
+		return
+	}
+
+	report, err := h.store.ActivateTeamUsers(r.Context(), req.TeamName, req.UserIDs, req.Rebalance)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrTeamNotFound):
+			writeError(w, "NOT_FOUND", "team not found", http.StatusNotFound)
+		default:
+			writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	activated := []models.UserActivationResult{}
+	for _, u := range report.Users {
+		if u.Status == models.ActivationStatusActivated {
+			activated = append(activated, u)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, models.ActivateTeamUsersResponse{
+		ActivationReport: report,
+		Activated:        activated,
+	})
+}
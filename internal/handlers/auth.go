@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/auth"
+	"github.com/2Empty/review-assigner/internal/logging"
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// EnableAuth включает проверку API-токенов: маршруты, зарегистрированные через
+// protect, требуют токен с нужной областью доступа, а /tokens/* — область admin.
+// Действия над командой, зарегистрированные через protectTeam, дополнительно
+// проверяются по ролям: руководитель управляет только своей командой.
+// Должен вызываться до SetupRoutes.
+func (h *Handler) EnableAuth(a *auth.Authenticator) {
+	h.auth = a
+	h.authz = auth.NewAuthorizer(h.store)
+}
+
+// protect оборачивает обработчик проверкой токена и области доступа scope.
+// Без EnableAuth обработчик возвращается как есть.
+func (h *Handler) protect(scope string, next http.HandlerFunc) http.HandlerFunc {
+	return h.authenticate(next, func(p *auth.Principal) error { return p.Require(scope) })
+}
+
+// protectTeam — protect для действий над командой: пользователи из JWT допускаются
+// к обработчику без области scope, он сам проверяет права через authorizeTeam.
+func (h *Handler) protectTeam(scope string, next http.HandlerFunc) http.HandlerFunc {
+	return h.authenticate(next, func(p *auth.Principal) error { return p.RequireTeam(scope) })
+}
+
+func (h *Handler) authenticate(next http.HandlerFunc, check func(*auth.Principal) error) http.HandlerFunc {
+	if h.auth == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := auth.BearerToken(r.Header.Get("Authorization"))
+		p, err := h.auth.Authenticate(r.Context(), token)
+		if err != nil {
+			if errors.Is(err, auth.ErrUnauthenticated) {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="review-assigner"`)
+				writeError(w, "UNAUTHORIZED", err.Error(), http.StatusUnauthorized)
+				return
+			}
+			writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := check(p); err != nil {
+			writeError(w, "FORBIDDEN", err.Error(), http.StatusForbidden)
+			return
+		}
+
+		// Изменения, сделанные запросом, записываются в журнал событий от имени вызывающего
+		ctx := auth.WithPrincipal(r.Context(), p)
+		ctx = store.WithActor(ctx, p.Actor())
+		ctx = logging.With(ctx, "caller", p.Actor())
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// authorizeTeam проверяет права вызывающего на команду team и при отказе пишет ответ.
+// Без EnableAuth всё разрешено.
+func (h *Handler) authorizeTeam(w http.ResponseWriter, r *http.Request, team string) bool {
+	if h.authz == nil {
+		return true
+	}
+	return writeAuthzError(w, h.authz.AuthorizeTeam(r.Context(), auth.FromContext(r.Context()), team))
+}
+
+// authorizeUser проверяет права вызывающего на команду пользователя userID.
+func (h *Handler) authorizeUser(w http.ResponseWriter, r *http.Request, userID string) bool {
+	if h.authz == nil {
+		return true
+	}
+	return writeAuthzError(w, h.authz.AuthorizeUser(r.Context(), auth.FromContext(r.Context()), userID))
+}
+
+// authorizePR проверяет права вызывающего на команду автора PR prID.
+func (h *Handler) authorizePR(w http.ResponseWriter, r *http.Request, prID string) bool {
+	if h.authz == nil {
+		return true
+	}
+	return writeAuthzError(w, h.authz.AuthorizePR(r.Context(), auth.FromContext(r.Context()), prID))
+}
+
+func writeAuthzError(w http.ResponseWriter, err error) bool {
+	if err == nil {
+		return true
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		writeError(w, "FORBIDDEN", err.Error(), http.StatusForbidden)
+		return false
+	}
+	writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+	return false
+}
+
+// CreateToken выпускает API-токен с заданными областями доступа.
+func (h *Handler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req models.CreateTokenRequest
+	if err := bindJSON(r, &req); err != nil {
+		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		writeError(w, "INVALID_REQUEST", "name is required", http.StatusBadRequest)
+		return
+	}
+	if err := models.ValidateScopes(req.Scopes); err != nil {
+		writeError(w, "INVALID_REQUEST", err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		writeError(w, "INVALID_REQUEST", "expires_at must be in the future", http.StatusBadRequest)
+		return
+	}
+
+	id, secret, hash := auth.NewToken()
+	token := models.APIToken{
+		ID:        id,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if err := h.store.CreateAPIToken(r.Context(), &token, hash); err != nil {
+		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, models.CreateTokenResponse{Token: token, Secret: secret})
+}
+
+// ListTokens возвращает API-токены без секретов.
+func (h *Handler) ListTokens(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	tokens, err := h.store.ListAPITokens(r.Context())
+	if err != nil {
+		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, models.ListTokensResponse{Tokens: tokens})
+}
+
+// RevokeToken отзывает API-токен.
+func (h *Handler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req models.RevokeTokenRequest
+	if err := bindJSON(r, &req); err != nil {
+		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.ID == "" {
+		writeError(w, "INVALID_REQUEST", "id is required", http.StatusBadRequest)
+		return
+	}
+
+	token, err := h.store.RevokeAPIToken(r.Context(), req.ID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			writeError(w, "NOT_FOUND", "token not found", http.StatusNotFound)
+			return
+		}
+		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, token)
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/stream"
+)
+
+// eventReplayPage — размер страницы при дочитывании журнала после Last-Event-ID.
+const eventReplayPage = 500
+
+// EnableEventStream включает GET /events/stream и подписывает поток на события store.
+// heartbeat — интервал комментариев-пульсов, не дающих прокси закрыть соединение.
+// Должен вызываться до SetupRoutes.
+func (h *Handler) EnableEventStream(heartbeat time.Duration) {
+	h.events = stream.NewHub()
+	h.eventHeartbeat = heartbeat
+	h.store.Subscribe(h.events)
+}
+
+// CloseEventStreams завершает все открытые потоки событий.
+// Вызывается при остановке сервера: http.Server.Shutdown не прерывает активные запросы.
+func (h *Handler) CloseEventStreams() {
+	if h.events != nil {
+		h.events.Close()
+	}
+}
+
+// StreamEvents отдаёт поток событий в формате Server-Sent Events.
+// Параметры team_name и user_id фильтруют события. Если передан заголовок
+// Last-Event-ID (или параметр last_event_id), сначала отдаются сохранённые
+// в журнале события после него, затем поток продолжается в реальном времени.
+func (h *Handler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := models.EventFilter{
+		TeamName: query.Get("team_name"),
+		UserID:   query.Get("user_id"),
+	}
+
+	lastID := r.Header.Get("Last-Event-ID")
+	if lastID == "" {
+		lastID = query.Get("last_event_id")
+	}
+	resume := lastID != ""
+	if resume {
+		id, err := strconv.ParseInt(lastID, 10, 64)
+		if err != nil || id < 0 {
+			writeError(w, "INVALID_REQUEST", "Last-Event-ID must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		filter.AfterID = id
+	}
+
+	// Подписка оформляется до чтения журнала, чтобы не потерять события между ними;
+	// дубликаты отсекаются по id.
+	sub := h.events.Subscribe(filter, 0)
+	defer h.events.Unsubscribe(sub)
+
+	rc := http.NewResponseController(w)
+	// Поток живёт дольше WriteTimeout сервера, поэтому дедлайн записи снимается
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		slog.WarnContext(r.Context(), "Failed to reset write deadline for event stream", "error", err)
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		slog.ErrorContext(r.Context(), "Event stream is not supported by response writer", "error", err)
+		return
+	}
+
+	if resume {
+		for {
+			page := filter
+			page.Limit = eventReplayPage
+			events, err := h.store.ListEvents(r.Context(), page)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Failed to replay events", "after_id", filter.AfterID, "error", err)
+				return
+			}
+			for _, e := range events {
+				if err := writeEvent(w, e); err != nil {
+					return
+				}
+				filter.AfterID = e.ID
+			}
+			if len(events) < eventReplayPage {
+				break
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(h.eventHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			if e.ID <= filter.AfterID {
+				continue
+			}
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+			filter.AfterID = e.ID
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent записывает событие в формате SSE.
+func writeEvent(w io.Writer, e models.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
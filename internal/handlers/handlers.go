@@ -3,42 +3,56 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"time"
 
+	"github.com/2Empty/review-assigner/internal/auth"
+	"github.com/2Empty/review-assigner/internal/forge"
+	"github.com/2Empty/review-assigner/internal/health"
 	"github.com/2Empty/review-assigner/internal/models"
 	"github.com/2Empty/review-assigner/internal/store"
+	"github.com/2Empty/review-assigner/internal/stream"
 )
 
 // Handler предоставляет методы для обработки HTTP запросов.
 type Handler struct {
 	store *store.Store
+	forge *forge.Dispatcher
+
+	events         *stream.Hub
+	eventHeartbeat time.Duration
+
+	readiness *health.Checker
+	metrics   http.Handler
+
+	auth  *auth.Authenticator
+	authz *auth.Authorizer
+
+	githubSecret []byte
+	gitlabToken  string
 }
 
 // NewHandler создает новый экземпляр Handler с переданным store.
 func NewHandler(store *store.Store) *Handler {
-	return &Handler{store: store}
-}
-
-// ErrorResponse представляет структуру ответа с ошибкой.
-type ErrorResponse struct {
-	Error struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
-	} `json:"error"`
+	return &Handler{
+		store: store,
+		forge: forge.NewDispatcher(store),
+	}
 }
 
 func writeJSON(w http.ResponseWriter, status int, data any) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	if err := json.NewEncoder(w).Encode(data); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
+		slog.Error("Failed to encode JSON response", "error", err)
 	}
 }
 
 func writeError(w http.ResponseWriter, code, message string, status int) {
-	resp := ErrorResponse{}
+	resp := models.ErrorResponse{}
 	resp.Error.Code = code
 	resp.Error.Message = message
 	writeJSON(w, status, resp)
@@ -51,7 +65,7 @@ func bindJSON(r *http.Request, v interface{}) error {
 	}
 	defer func() {
 		if err := r.Body.Close(); err != nil {
-			log.Printf("Failed to close request body: %v", err)
+			slog.Error("Failed to close request body", "error", err)
 		}
 	}()
 
@@ -86,12 +100,31 @@ func (h *Handler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	for _, m := range team.Members {
+		if m.Role != "" && !models.IsValidRole(m.Role) {
+			writeError(w, "INVALID_REQUEST", "invalid role for user "+m.UserID, http.StatusBadRequest)
+			return
+		}
+		if err := models.ValidateIdentities(m.Identities); err != nil {
+			writeError(w, "INVALID_REQUEST", err.Error()+" for user "+m.UserID, http.StatusBadRequest)
+			return
+		}
+	}
+	if err := models.ValidateRules(team.ReviewRules, h.store.ReviewersPerPR()); err != nil {
+		writeError(w, "INVALID_REQUEST", err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	err := h.store.CreateTeam(r.Context(), team)
 	if err != nil {
 		if err == store.ErrTeamExists {
 			writeError(w, "TEAM_EXISTS", "team_name already exists", http.StatusBadRequest)
 			return
 		}
+		if errors.Is(err, store.ErrIdentityExists) {
+			writeError(w, "IDENTITY_EXISTS", err.Error(), http.StatusConflict)
+			return
+		}
 		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -125,12 +158,6 @@ func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, team)
 }
 
-// SetUserActiveRequest представляет запрос на изменение активности пользователя.
-type SetUserActiveRequest struct {
-	UserID   string `json:"user_id"`
-	IsActive bool   `json:"is_active"`
-}
-
 // SetUserActive устанавливает флаг активности пользователя.
 func (h *Handler) SetUserActive(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -138,15 +165,19 @@ func (h *Handler) SetUserActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req SetUserActiveRequest
+	var req models.SetUserActiveRequest
 	if err := bindJSON(r, &req); err != nil {
 		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	user, err := h.store.SetUserActive(r.Context(), req.UserID, req.IsActive)
+	if !h.authorizeUser(w, r, req.UserID) {
+		return
+	}
+
+	user, plan, err := h.store.SetUserActive(r.Context(), req.UserID, req.IsActive, req.DryRun)
 	if err != nil {
-		if err == store.ErrNotFound {
+		if errors.Is(err, store.ErrNotFound) {
 			writeError(w, "NOT_FOUND", "user not found", http.StatusNotFound)
 			return
 		}
@@ -154,23 +185,17 @@ func (h *Handler) SetUserActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.DryRun {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"dry_run": true,
+			"user":    user,
+			"plan":    plan,
+		})
+		return
+	}
 	writeJSON(w, http.StatusOK, user)
 }
 
-// UserReviewsResponse представляет ответ со списком PR пользователя.
-type UserReviewsResponse struct {
-	UserID       string             `json:"user_id"`
-	PullRequests []PullRequestShort `json:"pull_requests"`
-}
-
-// PullRequestShort представляет сокращенную информацию о PR.
-type PullRequestShort struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
-	Status          string `json:"status"`
-}
-
 // GetUserReviews возвращает список PR, где пользователь назначен ревьювером.
 func (h *Handler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -190,9 +215,9 @@ func (h *Handler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	prShorts := make([]PullRequestShort, len(prs))
+	prShorts := make([]models.PullRequestShort, len(prs))
 	for i, pr := range prs {
-		prShorts[i] = PullRequestShort{
+		prShorts[i] = models.PullRequestShort{
 			PullRequestID:   pr.PullRequestID,
 			PullRequestName: pr.PullRequestName,
 			AuthorID:        pr.AuthorID,
@@ -200,19 +225,12 @@ func (h *Handler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	writeJSON(w, http.StatusOK, UserReviewsResponse{
+	writeJSON(w, http.StatusOK, models.UserReviewsResponse{
 		UserID:       userID,
 		PullRequests: prShorts,
 	})
 }
 
-// CreatePRRequest представляет запрос на создание PR.
-type CreatePRRequest struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
-}
-
 // CreatePR создает новый PR и назначает до 2 ревьюверов из команды автора.
 func (h *Handler) CreatePR(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -220,13 +238,13 @@ func (h *Handler) CreatePR(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req CreatePRRequest
+	var req models.CreatePRRequest
 	if err := bindJSON(r, &req); err != nil {
 		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	pr, err := h.store.CreatePR(r.Context(), req.PullRequestID, req.PullRequestName, req.AuthorID)
+	pr, err := h.store.CreatePR(r.Context(), req.PullRequestID, req.PullRequestName, req.AuthorID, req.DryRun)
 	if err != nil {
 		switch err {
 		case store.ErrPRExists:
@@ -239,14 +257,21 @@ func (h *Handler) CreatePR(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.DryRun {
+		assigned := make([]models.Reassignment, 0, len(pr.AssignedReviewers))
+		for _, id := range pr.AssignedReviewers {
+			assigned = append(assigned, models.Reassignment{PullRequestID: pr.PullRequestID, NewReviewerID: id})
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"dry_run": true,
+			"pr":      pr,
+			"plan":    models.NewChangePlan(true, pr.AssignedReviewers, assigned),
+		})
+		return
+	}
 	writeJSON(w, http.StatusCreated, pr)
 }
 
-// MergePRRequest представляет запрос на мерж PR.
-type MergePRRequest struct {
-	PullRequestID string `json:"pull_request_id"`
-}
-
 // MergePR помечает PR как MERGED (идемпотентная операция).
 func (h *Handler) MergePR(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -254,7 +279,7 @@ func (h *Handler) MergePR(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req MergePRRequest
+	var req models.MergePRRequest
 	if err := bindJSON(r, &req); err != nil {
 		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
 		return
@@ -273,18 +298,6 @@ func (h *Handler) MergePR(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, pr)
 }
 
-// ReassignReviewerRequest представляет запрос на переназначение ревьювера.
-type ReassignReviewerRequest struct {
-	PullRequestID string `json:"pull_request_id"`
-	OldUserID     string `json:"old_user_id"`
-}
-
-// ReassignReviewerResponse представляет ответ на переназначение ревьювера.
-type ReassignReviewerResponse struct {
-	PR         *models.PullRequest `json:"pr"`
-	ReplacedBy string              `json:"replaced_by"`
-}
-
 // ReassignReviewer переназначает ревьювера на другого участника из его команды.
 func (h *Handler) ReassignReviewer(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -292,19 +305,26 @@ func (h *Handler) ReassignReviewer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req ReassignReviewerRequest
+	var req models.ReassignReviewerRequest
 	if err := bindJSON(r, &req); err != nil {
 		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	pr, newUserID, err := h.store.ReassignReviewer(r.Context(), req.PullRequestID, req.OldUserID)
+	// PR принадлежит команде автора: руководитель переназначает ревью только на PR своей команды
+	if !h.authorizePR(w, r, req.PullRequestID) {
+		return
+	}
+
+	pr, newUserID, err := h.store.ReassignReviewer(r.Context(), req.PullRequestID, req.OldUserID, req.DryRun)
 	if err != nil {
 		switch err {
 		case store.ErrNotFound:
 			writeError(w, "NOT_FOUND", "PR or user not found", http.StatusNotFound)
 		case store.ErrPRMerged:
 			writeError(w, "PR_MERGED", "cannot reassign on merged PR", http.StatusConflict)
+		case store.ErrPRNotOpen:
+			writeError(w, "PR_NOT_OPEN", "cannot reassign on draft or closed PR", http.StatusConflict)
 		case store.ErrNotAssigned:
 			writeError(w, "NOT_ASSIGNED", "reviewer is not assigned to this PR", http.StatusConflict)
 		case store.ErrNoCandidate:
@@ -315,10 +335,19 @@ func (h *Handler) ReassignReviewer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSON(w, http.StatusOK, ReassignReviewerResponse{
+	resp := models.ReassignReviewerResponse{
 		PR:         pr,
 		ReplacedBy: newUserID,
-	})
+	}
+	if req.DryRun {
+		resp.DryRun = true
+		resp.Plan = models.NewChangePlan(true, []string{req.OldUserID, newUserID}, []models.Reassignment{{
+			PullRequestID: pr.PullRequestID,
+			OldReviewerID: req.OldUserID,
+			NewReviewerID: newUserID,
+		}})
+	}
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // GetStats возвращает статистику сервиса
@@ -337,12 +366,6 @@ func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, stats)
 }
 
-// DeactivateTeamUsersRequest представляет запрос на массовую деактивацию.
-type DeactivateTeamUsersRequest struct {
-	TeamName string   `json:"team_name"`
-	UserIDs  []string `json:"user_ids"`
-}
-
 // DeactivateTeamUsers деактивирует нескольких пользователей одной команды.
 func (h *Handler) DeactivateTeamUsers(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -350,7 +373,7 @@ func (h *Handler) DeactivateTeamUsers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req DeactivateTeamUsersRequest
+	var req models.DeactivateTeamUsersRequest
 	if err := bindJSON(r, &req); err != nil {
 		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
 		return
@@ -360,21 +383,42 @@ func (h *Handler) DeactivateTeamUsers(w http.ResponseWriter, r *http.Request) {
 		writeError(w, "INVALID_REQUEST", "team_name is required", http.StatusBadRequest)
 		return
 	}
+	// Пустая политика заменяется в store значением из конфигурации
+	if req.Policy != "" && req.Policy != models.PolicyAllOrNothing && req.Policy != models.PolicyBestEffort {
+		writeError(w, "INVALID_REQUEST", "policy must be all_or_nothing or best_effort", http.StatusBadRequest)
+		return
+	}
+
+	if !h.authorizeTeam(w, r, req.TeamName) {
+		return
+	}
 
-	users, err := h.store.DeactivateTeamUsers(r.Context(), req.TeamName, req.UserIDs)
+	report, err := h.store.DeactivateTeamUsers(r.Context(), req.TeamName, req.UserIDs, req.Policy, req.DryRun)
 	if err != nil {
-		switch err {
-		case store.ErrNotFound:
-			writeError(w, "NOT_FOUND", "team or user not found", http.StatusNotFound)
+		switch {
+		case errors.Is(err, store.ErrTeamNotFound):
+			writeError(w, "NOT_FOUND", "team not found", http.StatusNotFound)
+		case errors.Is(err, store.ErrDeactivationFailed):
+			resp := models.DeactivationFailedResponse{Report: report}
+			resp.Error.Code = "DEACTIVATION_FAILED"
+			resp.Error.Message = "some users could not be deactivated, changes rolled back"
+			writeJSON(w, http.StatusConflict, resp)
 		default:
 			writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	writeJSON(w, http.StatusOK, map[string]any{
-		"team_name": req.TeamName,
-		"updated":   users,
+	updated := []models.User{}
+	for _, u := range report.Users {
+		if u.Status == models.DeactivationStatusDeactivated {
+			updated = append(updated, *u.User)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, models.DeactivateTeamUsersResponse{
+		DeactivationReport: report,
+		Updated:            updated,
 	})
 }
 
@@ -382,20 +426,59 @@ func (h *Handler) DeactivateTeamUsers(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) SetupRoutes(mux *http.ServeMux) {
 	// Health
 	mux.HandleFunc("GET /health", h.Health)
+	mux.HandleFunc("GET /livez", h.Livez)
+	if h.readiness != nil {
+		mux.HandleFunc("GET /readyz", h.Readyz)
+	}
 
 	// Teams
-	mux.HandleFunc("POST /team/add", h.CreateTeam)
-	mux.HandleFunc("GET /team/get", h.GetTeam)
+	mux.HandleFunc("POST /team/add", h.protect(models.ScopeTeamAdmin, h.CreateTeam))
+	mux.HandleFunc("GET /team/get", h.protect(models.ScopeRead, h.GetTeam))
+	mux.HandleFunc("GET /team/list", h.protect(models.ScopeRead, h.ListTeams))
+	mux.HandleFunc("POST /team/setRules", h.protect(models.ScopeTeamAdmin, h.SetTeamRules))
+	mux.HandleFunc("POST /team/sync", h.protect(models.ScopeTeamAdmin, h.SyncTeams))
 
 	// Users
-	mux.HandleFunc("POST /users/setIsActive", h.SetUserActive)
-	mux.HandleFunc("GET /users/getReview", h.GetUserReviews)
-	mux.HandleFunc("POST /users/deactivateTeamUsers", h.DeactivateTeamUsers)
+	mux.HandleFunc("POST /users/setIsActive", h.protectTeam(models.ScopeTeamAdmin, h.SetUserActive))
+	mux.HandleFunc("GET /users/getReview", h.protect(models.ScopeRead, h.GetUserReviews))
+	mux.HandleFunc("GET /users/get", h.protect(models.ScopeRead, h.GetUser))
+	mux.HandleFunc("GET /users/search", h.protect(models.ScopeRead, h.SearchUsers))
+	mux.HandleFunc("POST /users/deactivateTeamUsers", h.protectTeam(models.ScopeTeamAdmin, h.DeactivateTeamUsers))
+	mux.HandleFunc("POST /users/activateTeamUsers", h.protectTeam(models.ScopeTeamAdmin, h.ActivateTeamUsers))
+
+	// Identities
+	mux.HandleFunc("POST /users/setIdentity", h.protect(models.ScopeTeamAdmin, h.SetIdentity))
+	mux.HandleFunc("GET /users/getIdentities", h.protect(models.ScopeRead, h.GetIdentities))
+	mux.HandleFunc("POST /users/deleteIdentity", h.protect(models.ScopeTeamAdmin, h.DeleteIdentity))
+	mux.HandleFunc("GET /users/lookupIdentity", h.protect(models.ScopeRead, h.LookupIdentity))
 
 	// PullRequests
-	mux.HandleFunc("POST /pullRequest/create", h.CreatePR)
-	mux.HandleFunc("POST /pullRequest/merge", h.MergePR)
-	mux.HandleFunc("POST /pullRequest/reassign", h.ReassignReviewer)
+	mux.HandleFunc("POST /pullRequest/create", h.protect(models.ScopePRWrite, h.CreatePR))
+	mux.HandleFunc("POST /pullRequest/merge", h.protect(models.ScopePRWrite, h.MergePR))
+	mux.HandleFunc("POST /pullRequest/reassign", h.protectTeam(models.ScopePRWrite, h.ReassignReviewer))
 	// Stats
-	mux.HandleFunc("GET /stats", h.GetStats)
+	mux.HandleFunc("GET /stats", h.protect(models.ScopeStats, h.GetStats))
+	if h.metrics != nil {
+		mux.HandleFunc("GET /metrics", h.protect(models.ScopeStats, h.metrics.ServeHTTP))
+	}
+
+	// Events
+	if h.events != nil {
+		mux.HandleFunc("GET /events/stream", h.protect(models.ScopeRead, h.StreamEvents))
+	}
+
+	// API tokens
+	if h.auth != nil {
+		mux.HandleFunc("POST /tokens/create", h.protect(models.ScopeAdmin, h.CreateToken))
+		mux.HandleFunc("GET /tokens/list", h.protect(models.ScopeAdmin, h.ListTokens))
+		mux.HandleFunc("POST /tokens/revoke", h.protect(models.ScopeAdmin, h.RevokeToken))
+	}
+
+	// Webhooks
+	if len(h.githubSecret) > 0 {
+		mux.HandleFunc("POST /webhooks/github", h.GitHubWebhook)
+	}
+	if h.gitlabToken != "" {
+		mux.HandleFunc("POST /webhooks/gitlab", h.GitLabWebhook)
+	}
 }
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// SetIdentity привязывает внешнюю учётную запись к пользователю.
+func (h *Handler) SetIdentity(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req models.Identity
+	if err := bindJSON(r, &req); err != nil {
+		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.UserID == "" {
+		writeError(w, "INVALID_REQUEST", "user_id is required", http.StatusBadRequest)
+		return
+	}
+	if err := models.ValidateIdentities([]models.Identity{req}); err != nil {
+		writeError(w, "INVALID_REQUEST", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.store.SetIdentity(r.Context(), req); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			writeError(w, "NOT_FOUND", "user not found", http.StatusNotFound)
+		case errors.Is(err, store.ErrIdentityExists):
+			writeError(w, "IDENTITY_EXISTS", "identity is linked to another user", http.StatusConflict)
+		default:
+			writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, req)
+}
+
+// GetIdentities возвращает внешние учётные записи пользователя.
+func (h *Handler) GetIdentities(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		writeError(w, "INVALID_REQUEST", "user_id is required", http.StatusBadRequest)
+		return
+	}
+
+	identities, err := h.store.ListIdentities(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			writeError(w, "NOT_FOUND", "user not found", http.StatusNotFound)
+			return
+		}
+		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if identities == nil {
+		identities = []models.Identity{}
+	}
+
+	writeJSON(w, http.StatusOK, models.GetIdentitiesResponse{
+		UserID:     userID,
+		Identities: identities,
+	})
+}
+
+// DeleteIdentity удаляет привязку пользователя к провайдеру.
+func (h *Handler) DeleteIdentity(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req models.DeleteIdentityRequest
+	if err := bindJSON(r, &req); err != nil {
+		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.UserID == "" || req.Provider == "" {
+		writeError(w, "INVALID_REQUEST", "user_id and provider are required", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.store.DeleteIdentity(r.Context(), req.UserID, req.Provider); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			writeError(w, "NOT_FOUND", "identity not found", http.StatusNotFound)
+			return
+		}
+		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, req)
+}
+
+// LookupIdentity находит пользователя по внешней учётной записи.
+func (h *Handler) LookupIdentity(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	provider := r.URL.Query().Get("provider")
+	externalID := r.URL.Query().Get("external_id")
+	if provider == "" || externalID == "" {
+		writeError(w, "INVALID_REQUEST", "provider and external_id are required", http.StatusBadRequest)
+		return
+	}
+
+	identity, err := h.store.LookupIdentity(r.Context(), provider, externalID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			writeError(w, "NOT_FOUND", "identity not found", http.StatusNotFound)
+			return
+		}
+		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, identity)
+}
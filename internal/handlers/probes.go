@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/2Empty/review-assigner/internal/health"
+)
+
+// EnableReadiness включает GET /readyz с проверками checker.
+// Должен вызываться до SetupRoutes.
+func (h *Handler) EnableReadiness(checker *health.Checker) {
+	h.readiness = checker
+}
+
+// EnableMetrics включает GET /metrics с областью stats: метрики раскрывают
+// маршруты и объём работы, поэтому отдаются только при аутентификации.
+// Должен вызываться до SetupRoutes.
+func (h *Handler) EnableMetrics(handler http.Handler) {
+	h.metrics = handler
+}
+
+// Livez сообщает, что процесс жив и обрабатывает запросы. Зависимости не проверяются,
+// чтобы недоступность базы не приводила к перезапуску контейнера.
+func (h *Handler) Livez(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Readyz выполняет проверки готовности и возвращает результат каждой из них.
+// Если хотя бы одна проверка не прошла, ответ имеет статус 503.
+func (h *Handler) Readyz(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	report := h.readiness.Check(r.Context())
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, report)
+}
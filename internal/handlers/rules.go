@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// SetTeamRules заменяет правила ролей, которые учитываются при назначении ревьюеров.
+func (h *Handler) SetTeamRules(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req models.SetTeamRulesRequest
+	if err := bindJSON(r, &req); err != nil {
+		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.TeamName == "" {
+		writeError(w, "INVALID_REQUEST", "team_name is required", http.StatusBadRequest)
+		return
+	}
+	if err := models.ValidateRules(req.ReviewRules, h.store.ReviewersPerPR()); err != nil {
+		writeError(w, "INVALID_REQUEST", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.store.SetTeamRules(r.Context(), req.TeamName, req.ReviewRules); err != nil {
+		if errors.Is(err, store.ErrTeamNotFound) {
+			writeError(w, "NOT_FOUND", "team not found", http.StatusNotFound)
+			return
+		}
+		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, req)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// maxSyncBody ограничивает размер описания команд.
+const maxSyncBody = 5 << 20
+
+// SyncTeams приводит составы команд к описанию в теле запроса (YAML или JSON).
+// С параметром dry_run=true возвращает план изменений, не применяя его.
+func (h *Handler) SyncTeams(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			writeError(w, "INVALID_REQUEST", "dry_run must be a boolean", http.StatusBadRequest)
+			return
+		}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxSyncBody))
+	if err != nil {
+		writeError(w, "INVALID_REQUEST", "invalid request body", http.StatusBadRequest)
+		return
+	}
+	spec, err := models.ParseTeamSyncSpec(body)
+	if err != nil {
+		writeError(w, "INVALID_REQUEST", err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := spec.Validate(h.store.ReviewersPerPR()); err != nil {
+		writeError(w, "INVALID_REQUEST", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	plan, err := h.store.SyncTeams(r.Context(), *spec, dryRun)
+	if err != nil {
+		if errors.Is(err, store.ErrIdentityExists) {
+			writeError(w, "IDENTITY_EXISTS", err.Error(), http.StatusConflict)
+			return
+		}
+		writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, plan)
+}
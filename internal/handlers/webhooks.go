@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/2Empty/review-assigner/internal/forge"
+	"github.com/2Empty/review-assigner/internal/forge/github"
+	"github.com/2Empty/review-assigner/internal/forge/gitlab"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// maxWebhookBody ограничивает размер тела вебхука.
+const maxWebhookBody = 5 << 20
+
+// EnableGitHubWebhook включает приём вебхуков GitHub с проверкой подписи секретом.
+// Должен вызываться до SetupRoutes.
+func (h *Handler) EnableGitHubWebhook(secret string) {
+	h.githubSecret = []byte(secret)
+}
+
+// EnableGitLabWebhook включает приём вебхуков GitLab с проверкой заголовка X-Gitlab-Token.
+// Должен вызываться до SetupRoutes.
+func (h *Handler) EnableGitLabWebhook(token string) {
+	h.gitlabToken = token
+}
+
+// GitHubWebhook принимает вебхуки GitHub pull_request и применяет их к store.
+func (h *Handler) GitHubWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		writeError(w, "INVALID_REQUEST", "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if !github.VerifySignature(h.githubSecret, body, r.Header.Get(github.HeaderSignature)) {
+		writeError(w, "INVALID_SIGNATURE", "signature mismatch", http.StatusUnauthorized)
+		return
+	}
+
+	eventType := r.Header.Get(github.HeaderEvent)
+	if eventType == "ping" {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "pong"})
+		return
+	}
+
+	ev, err := github.ParseEvent(eventType, body)
+	if err != nil {
+		if errors.Is(err, forge.ErrIgnored) {
+			writeJSON(w, http.StatusAccepted, map[string]string{"status": "ignored", "reason": err.Error()})
+			return
+		}
+		writeError(w, "INVALID_REQUEST", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.applyForgeEvent(w, r, ev)
+}
+
+// GitLabWebhook принимает вебхуки GitLab Merge Request Hook и применяет их к store.
+func (h *Handler) GitLabWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, "METHOD_NOT_ALLOWED", "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !gitlab.VerifyToken(h.gitlabToken, r.Header.Get(gitlab.HeaderToken)) {
+		writeError(w, "INVALID_TOKEN", "token mismatch", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		writeError(w, "INVALID_REQUEST", "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	ev, err := gitlab.ParseEvent(r.Header.Get(gitlab.HeaderEvent), body)
+	if err != nil {
+		if errors.Is(err, forge.ErrIgnored) {
+			writeJSON(w, http.StatusAccepted, map[string]string{"status": "ignored", "reason": err.Error()})
+			return
+		}
+		writeError(w, "INVALID_REQUEST", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.applyForgeEvent(w, r, ev)
+}
+
+// applyForgeEvent применяет событие внешней системы и пишет ответ.
+func (h *Handler) applyForgeEvent(w http.ResponseWriter, r *http.Request, ev forge.Event) {
+	res, err := h.forge.Apply(r.Context(), ev)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to apply forge event", "provider", ev.Provider, "action", ev.Action, "pr_id", ev.PullRequestID(), "error", err)
+		switch {
+		case errors.Is(err, forge.ErrIgnored):
+			writeJSON(w, http.StatusAccepted, map[string]string{"status": "ignored", "reason": err.Error()})
+		case errors.Is(err, forge.ErrUnknownUser):
+			writeError(w, "UNKNOWN_USER", err.Error(), http.StatusUnprocessableEntity)
+		case errors.Is(err, store.ErrNotFound):
+			writeError(w, "NOT_FOUND", "PR or author not found", http.StatusNotFound)
+		case errors.Is(err, store.ErrPRMerged):
+			writeError(w, "PR_MERGED", "PR already merged", http.StatusConflict)
+		case errors.Is(err, store.ErrPRNotOpen):
+			writeError(w, "PR_NOT_OPEN", "PR is closed, reopen it first", http.StatusConflict)
+		default:
+			writeError(w, "INTERNAL_ERROR", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
@@ -0,0 +1,166 @@
+// Package health собирает проверки готовности сервиса и состояние фоновых воркеров.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// checkTimeout ограничивает время одной проверки.
+const checkTimeout = 2 * time.Second
+
+// Статусы проверок.
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// ErrShuttingDown возвращается проверкой shutdown во время остановки сервиса.
+var ErrShuttingDown = errors.New("server is shutting down")
+
+// CheckFunc проверяет одну зависимость; nil означает, что она исправна.
+type CheckFunc func(ctx context.Context) error
+
+// CheckResult — результат одной проверки.
+type CheckResult struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	Error      string  `json:"error,omitempty"`
+	DurationMS float64 `json:"duration_ms"`
+}
+
+// Report — результат всех проверок готовности.
+type Report struct {
+	Ready  bool          `json:"ready"`
+	Checks []CheckResult `json:"checks"`
+}
+
+type namedCheck struct {
+	name string
+	fn   CheckFunc
+}
+
+// Checker выполняет зарегистрированные проверки готовности.
+type Checker struct {
+	mu           sync.RWMutex
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+// NewChecker создает Checker с проверкой shutdown, которая не проходит после SetShuttingDown.
+func NewChecker() *Checker {
+	c := &Checker{}
+	c.Add("shutdown", func(context.Context) error {
+		if c.shuttingDown.Load() {
+			return ErrShuttingDown
+		}
+		return nil
+	})
+	return c
+}
+
+// Add регистрирует проверку.
+func (c *Checker) Add(name string, fn CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, fn: fn})
+}
+
+// SetShuttingDown переводит сервис в состояние «не готов» на время остановки,
+// чтобы балансировщик перестал направлять новые запросы.
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Check выполняет все проверки параллельно и возвращает отчёт в порядке регистрации.
+func (c *Checker) Check(ctx context.Context) Report {
+	c.mu.RLock()
+	checks := c.checks
+	c.mu.RUnlock()
+
+	report := Report{Ready: true, Checks: make([]CheckResult, len(checks))}
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	for _, r := range report.Checks {
+		if r.Status != StatusOK {
+			report.Ready = false
+		}
+	}
+	return report
+}
+
+func run(ctx context.Context, check namedCheck) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.fn(ctx)
+	result := CheckResult{
+		Name:       check.name,
+		Status:     StatusOK,
+		DurationMS: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Workers отслеживает фоновые воркеры. Воркер, завершившийся до остановки
+// сервиса, считается упавшим, и проверка Check не проходит.
+type Workers struct {
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+// NewWorkers создает пустой реестр воркеров.
+func NewWorkers() *Workers {
+	return &Workers{running: make(map[string]bool)}
+}
+
+// Started отмечает запуск воркера.
+func (w *Workers) Started(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.running[name] = true
+}
+
+// Stopped отмечает завершение воркера.
+func (w *Workers) Stopped(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.running[name] = false
+}
+
+// Check возвращает ошибку, если какой-либо воркер не работает.
+func (w *Workers) Check(context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var stopped []string
+	for name, running := range w.running {
+		if !running {
+			stopped = append(stopped, name)
+		}
+	}
+	if len(stopped) > 0 {
+		sort.Strings(stopped)
+		return fmt.Errorf("workers stopped: %s", strings.Join(stopped, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Package logging настраивает структурированное журналирование slog и переносит
+// в записи идентификатор запроса и доменные атрибуты из контекста.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Форматы вывода журнала.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+type ctxKey int
+
+const (
+	requestIDKey ctxKey = iota
+	attrsKey
+)
+
+// New создаёт логгер, который дополняет записи атрибутами из контекста:
+// request_id, атрибутами из With и trace_id/span_id текущего спана.
+func New(w io.Writer, format string, level slog.Level) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{Level: level}
+	var h slog.Handler
+	switch format {
+	case "", FormatJSON:
+		h = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		h = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+	return slog.New(contextHandler{h}), nil
+}
+
+// WithRequestID сохраняет идентификатор запроса в контексте.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID возвращает идентификатор запроса из контекста или пустую строку.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// With добавляет к контексту атрибуты (пары ключ-значение, как в slog.Logger.With),
+// которые попадут во все записи, сделанные с этим контекстом. Атрибут с уже
+// существующим ключом заменяет прежний.
+func With(ctx context.Context, args ...any) context.Context {
+	prev, _ := ctx.Value(attrsKey).([]slog.Attr)
+	attrs := slices.Clone(prev)
+
+	r := slog.NewRecord(time.Time{}, 0, "", 0)
+	r.Add(args...)
+	r.Attrs(func(a slog.Attr) bool {
+		i := slices.IndexFunc(attrs, func(b slog.Attr) bool { return b.Key == a.Key })
+		if i >= 0 {
+			attrs[i] = a
+		} else {
+			attrs = append(attrs, a)
+		}
+		return true
+	})
+	return context.WithValue(ctx, attrsKey, attrs)
+}
+
+// contextHandler добавляет к записи атрибуты из контекста.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if attrs, ok := ctx.Value(attrsKey).([]slog.Attr); ok {
+		// Атрибуты, переданные в самом вызове, важнее атрибутов контекста
+		own := make(map[string]bool, r.NumAttrs())
+		r.Attrs(func(a slog.Attr) bool {
+			own[a.Key] = true
+			return true
+		})
+		for _, a := range attrs {
+			if !own[a.Key] {
+				r.AddAttrs(a)
+			}
+		}
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/logging"
+)
+
+// unmatchedRoute — метка запросов, не попавших ни в один маршрут.
+const unmatchedRoute = "unmatched"
+
+// Middleware считает запросы и их длительность. В метку route попадает шаблон
+// маршрута ServeMux, а не путь, чтобы число рядов оставалось ограниченным.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := logging.NewStatusRecorder(w)
+		next.ServeHTTP(rec, r)
+
+		// ServeMux записывает найденный шаблон в r.Pattern
+		route := r.Pattern
+		if route == "" {
+			route = unmatchedRoute
+		}
+		status := strconv.Itoa(rec.Status())
+		httpRequests.WithLabelValues(route, r.Method, status).Inc()
+		httpDuration.WithLabelValues(route, r.Method, status).Observe(time.Since(start).Seconds())
+	})
+}
@@ -0,0 +1,102 @@
+// Package metrics описывает метрики Prometheus сервиса: HTTP-запросы,
+// состояние пула соединений и доменные счётчики назначений.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "review_assigner"
+
+// Registry содержит все метрики сервиса, включая метрики Go-рантайма и процесса.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route pattern, method and status code.",
+	}, []string{"route", "method", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route pattern, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	prsCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "prs_created_total",
+		Help:      "Pull requests created.",
+	})
+
+	reviewersAssigned = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reviewers_assigned_total",
+		Help:      "Reviewers assigned to pull requests on creation or when leaving draft.",
+	})
+
+	reassignments = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reassignments_total",
+		Help:      "Reviewer replacements by reason.",
+	}, []string{"reason"})
+
+	noCandidate = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "no_candidate_total",
+		Help:      "Reviewers removed from a PR without a replacement because no candidate was found.",
+	})
+
+	deactivations = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "user_deactivations_total",
+		Help:      "Users deactivated.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		prsCreated,
+		reviewersAssigned,
+		reassignments,
+		noCandidate,
+		deactivations,
+	)
+}
+
+// Handler отдаёт метрики в формате Prometheus.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Events реализует store.Listener и считает доменные события после коммита.
+type Events struct{}
+
+// HandleEvents обновляет счётчики по событиям.
+func (Events) HandleEvents(events []models.Event) {
+	for _, e := range events {
+		switch e.Type {
+		case models.EventPRCreated:
+			prsCreated.Inc()
+		case models.EventReviewerAssigned:
+			reviewersAssigned.Inc()
+		case models.EventReviewerReplaced:
+			reassignments.WithLabelValues(e.Reason).Inc()
+		case models.EventReviewerRemoved:
+			noCandidate.Inc()
+		case models.EventUserDeactivated:
+			deactivations.Inc()
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// scrapeTimeout ограничивает запрос открытых ревью при сборе метрик.
+const scrapeTimeout = 2 * time.Second
+
+// StoreSource — данные store, читаемые при каждом сборе метрик.
+type StoreSource interface {
+	PoolStat() *pgxpool.Stat
+	OpenReviewsByTeam(ctx context.Context) (map[string]int, error)
+}
+
+// storeCollector снимает статистику пула pgx и нагрузку команд в момент сбора.
+type storeCollector struct {
+	src StoreSource
+
+	acquiredConns    *prometheus.Desc
+	idleConns        *prometheus.Desc
+	totalConns       *prometheus.Desc
+	maxConns         *prometheus.Desc
+	acquireCount     *prometheus.Desc
+	acquireDuration  *prometheus.Desc
+	emptyAcquire     *prometheus.Desc
+	canceledAcquire  *prometheus.Desc
+	newConns         *prometheus.Desc
+	openReviews      *prometheus.Desc
+	openReviewsError *prometheus.Desc
+}
+
+// RegisterStore добавляет в Registry метрики пула соединений и открытых ревью по командам.
+func RegisterStore(src StoreSource) {
+	pool := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	Registry.MustRegister(&storeCollector{
+		src:             src,
+		acquiredConns:   pool("acquired_conns", "Connections currently acquired from the pool."),
+		idleConns:       pool("idle_conns", "Idle connections in the pool."),
+		totalConns:      pool("total_conns", "Total connections in the pool."),
+		maxConns:        pool("max_conns", "Maximum size of the pool."),
+		acquireCount:    pool("acquires_total", "Successful connection acquisitions."),
+		acquireDuration: pool("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		emptyAcquire:    pool("empty_acquires_total", "Acquisitions that had to wait for a connection."),
+		canceledAcquire: pool("canceled_acquires_total", "Acquisitions canceled by context."),
+		newConns:        pool("new_conns_total", "Connections opened by the pool."),
+		openReviews: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "open_reviews"),
+			"Reviewer assignments on OPEN pull requests by reviewer team.", []string{"team"}, nil),
+		openReviewsError: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "open_reviews_scrape_error"),
+			"1 if open reviews could not be read during the last scrape.", nil, nil),
+	})
+}
+
+func (c *storeCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *storeCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.src.PoolStat()
+	gauge := func(d *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, v)
+	}
+	counter := func(d *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.CounterValue, v)
+	}
+	gauge(c.acquiredConns, float64(s.AcquiredConns()))
+	gauge(c.idleConns, float64(s.IdleConns()))
+	gauge(c.totalConns, float64(s.TotalConns()))
+	gauge(c.maxConns, float64(s.MaxConns()))
+	counter(c.acquireCount, float64(s.AcquireCount()))
+	counter(c.acquireDuration, s.AcquireDuration().Seconds())
+	counter(c.emptyAcquire, float64(s.EmptyAcquireCount()))
+	counter(c.canceledAcquire, float64(s.CanceledAcquireCount()))
+	counter(c.newConns, float64(s.NewConnsCount()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+	counts, err := c.src.OpenReviewsByTeam(ctx)
+	if err != nil {
+		slog.Error("Failed to collect open reviews metric", "error", err)
+		gauge(c.openReviewsError, 1)
+		return
+	}
+	gauge(c.openReviewsError, 0)
+	for team, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.openReviews, prometheus.GaugeValue, float64(n), team)
+	}
+}
@@ -0,0 +1,144 @@
+package models
+
+import "time"
+
+// Запросы и ответы HTTP API. Общие для обработчиков сервера и клиента reviewctl.
+
+// ErrorResponse представляет структуру ответа с ошибкой.
+type ErrorResponse struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// SetUserActiveRequest представляет запрос на изменение активности пользователя.
+type SetUserActiveRequest struct {
+	UserID   string `json:"user_id"`
+	IsActive bool   `json:"is_active"`
+	DryRun   bool   `json:"dry_run"`
+}
+
+// UserReviewsResponse представляет ответ со списком PR пользователя.
+type UserReviewsResponse struct {
+	UserID       string             `json:"user_id"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+}
+
+// CreatePRRequest представляет запрос на создание PR.
+type CreatePRRequest struct {
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	AuthorID        string `json:"author_id"`
+	DryRun          bool   `json:"dry_run"`
+}
+
+// MergePRRequest представляет запрос на мерж PR.
+type MergePRRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+}
+
+// ReassignReviewerRequest представляет запрос на переназначение ревьювера.
+type ReassignReviewerRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldUserID     string `json:"old_user_id"`
+	DryRun        bool   `json:"dry_run"`
+}
+
+// ReassignReviewerResponse представляет ответ на переназначение ревьювера.
+type ReassignReviewerResponse struct {
+	PR         *PullRequest `json:"pr"`
+	ReplacedBy string       `json:"replaced_by"`
+	DryRun     bool         `json:"dry_run,omitempty"`
+	Plan       *ChangePlan  `json:"plan,omitempty"`
+}
+
+// DeactivateTeamUsersRequest представляет запрос на массовую деактивацию.
+type DeactivateTeamUsersRequest struct {
+	TeamName string   `json:"team_name"`
+	UserIDs  []string `json:"user_ids"`
+	Policy   string   `json:"policy"` //[all_or_nothing, best_effort]
+	DryRun   bool     `json:"dry_run"`
+}
+
+// DeactivateTeamUsersResponse представляет отчёт о массовой деактивации.
+type DeactivateTeamUsersResponse struct {
+	*DeactivationReport
+	Updated []User `json:"updated"`
+}
+
+// DeactivationFailedResponse возвращается, когда политика all_or_nothing откатила операцию.
+type DeactivationFailedResponse struct {
+	ErrorResponse
+	Report *DeactivationReport `json:"report"`
+}
+
+// ActivateTeamUsersRequest представляет запрос на массовую активацию.
+type ActivateTeamUsersRequest struct {
+	TeamName  string   `json:"team_name"`
+	UserIDs   []string `json:"user_ids"`
+	Rebalance bool     `json:"rebalance"`
+}
+
+// ActivateTeamUsersResponse представляет отчёт о массовой активации.
+type ActivateTeamUsersResponse struct {
+	*ActivationReport
+	Activated []UserActivationResult `json:"activated"`
+}
+
+// CreateTokenRequest представляет запрос на выпуск API-токена.
+type CreateTokenRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CreateTokenResponse содержит выпущенный токен. Секрет показывается только один раз.
+type CreateTokenResponse struct {
+	Token  APIToken `json:"token"`
+	Secret string   `json:"secret"`
+}
+
+// RevokeTokenRequest представляет запрос на отзыв API-токена.
+type RevokeTokenRequest struct {
+	ID string `json:"id"`
+}
+
+// ListTokensResponse представляет список API-токенов.
+type ListTokensResponse struct {
+	Tokens []APIToken `json:"tokens"`
+}
+
+// ListTeamsResponse представляет страницу списка команд.
+type ListTeamsResponse struct {
+	Teams  []TeamSummary `json:"teams"`
+	Total  int           `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+}
+
+// SearchUsersResponse представляет страницу результатов поиска пользователей.
+type SearchUsersResponse struct {
+	Users  []UserDetails `json:"users"`
+	Total  int           `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+}
+
+// DeleteIdentityRequest представляет запрос на удаление привязки.
+type DeleteIdentityRequest struct {
+	UserID   string `json:"user_id"`
+	Provider string `json:"provider"`
+}
+
+// GetIdentitiesResponse представляет внешние учётные записи пользователя.
+type GetIdentitiesResponse struct {
+	UserID     string     `json:"user_id"`
+	Identities []Identity `json:"identities"`
+}
+
+// SetTeamRulesRequest представляет запрос на замену правил назначения команды.
+type SetTeamRulesRequest struct {
+	TeamName    string       `json:"team_name"`
+	ReviewRules []ReviewRule `json:"review_rules"`
+}
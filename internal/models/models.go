@@ -3,12 +3,54 @@ package models
 
 import "time"
 
+// Роли участников команды в порядке возрастания старшинства.
+const (
+	RoleJunior = "junior"
+	RoleSenior = "senior"
+	RoleLead   = "lead"
+)
+
+// IsValidRole сообщает, является ли роль допустимой.
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleJunior, RoleSenior, RoleLead:
+		return true
+	}
+	return false
+}
+
+// Провайдеры внешних учётных записей пользователей.
+const (
+	ProviderGitHub = "github"
+	ProviderGitLab = "gitlab"
+	ProviderSlack  = "slack"
+	ProviderEmail  = "email"
+)
+
+// IsValidProvider сообщает, поддерживается ли провайдер внешних учётных записей.
+func IsValidProvider(provider string) bool {
+	switch provider {
+	case ProviderGitHub, ProviderGitLab, ProviderSlack, ProviderEmail:
+		return true
+	}
+	return false
+}
+
+// Identity связывает пользователя с его учётной записью у внешнего провайдера.
+// Внутри TeamMember поле UserID не заполняется.
+type Identity struct {
+	UserID     string `json:"user_id,omitempty"`
+	Provider   string `json:"provider"` //[github, gitlab, slack, email]
+	ExternalID string `json:"external_id"`
+}
+
 // User представляет пользователя системы.
 type User struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
 	TeamName string `json:"team_name"`
 	IsActive bool   `json:"is_active"`
+	Role     string `json:"role"`
 }
 
 // TeamMember представляет участника команды.
@@ -16,12 +58,29 @@ type TeamMember struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
 	IsActive bool   `json:"is_active"`
+	Role     string `json:"role,omitempty"` //[junior, senior, lead]
+	// Identities — учётные записи участника во внешних системах.
+	Identities []Identity `json:"identities,omitempty"`
+}
+
+// ReviewRule задаёт требование "не менее MinCount ревьюеров с ролью не ниже Role".
+type ReviewRule struct {
+	Role     string `json:"role"`
+	MinCount int    `json:"min_count"`
+}
+
+// RuleViolation описывает правило назначения, которое не удалось выполнить.
+type RuleViolation struct {
+	Role     string `json:"role"`
+	Required int    `json:"required"`
+	Assigned int    `json:"assigned"`
 }
 
 // Team представляет команду пользователей.
 type Team struct {
-	TeamName string       `json:"team_name"`
-	Members  []TeamMember `json:"members"`
+	TeamName    string       `json:"team_name"`
+	Members     []TeamMember `json:"members"`
+	ReviewRules []ReviewRule `json:"review_rules,omitempty"`
 }
 
 // PullRequest представляет pull request.
@@ -29,10 +88,12 @@ type PullRequest struct {
 	PullRequestID     string     `json:"pull_request_id"`
 	PullRequestName   string     `json:"pull_request_name"`
 	AuthorID          string     `json:"author_id"`
-	Status            string     `json:"status"` //[OPEN, MERGED]
+	Status            string     `json:"status"` //[DRAFT, OPEN, MERGED, CLOSED]
 	AssignedReviewers []string   `json:"assigned_reviewers"`
 	CreatedAt         time.Time  `json:"createdAt"`
 	MergedAt          *time.Time `json:"mergedAt"`
+	// RuleViolations заполняется, если правила ролей команды не удалось выполнить.
+	RuleViolations []RuleViolation `json:"rule_violations,omitempty"`
 }
 
 // PullRequestShort представляет сокращенную информацию о PR.
@@ -51,3 +112,289 @@ type Stats struct {
 	ActiveUsers   int            `json:"active_users"`
 	TotalTeams    int            `json:"total_teams"`
 }
+
+// TeamSummary представляет краткую информацию о команде для списка команд.
+type TeamSummary struct {
+	TeamName     string `json:"team_name"`
+	MembersCount int    `json:"members_count"`
+	ActiveCount  int    `json:"active_count"`
+}
+
+// UserDetails представляет пользователя вместе с количеством открытых ревью.
+type UserDetails struct {
+	User
+	OpenReviews int `json:"open_reviews"`
+}
+
+// UserSearchFilter задаёт параметры поиска пользователей.
+type UserSearchFilter struct {
+	Query    string // префикс user_id или username
+	TeamName string
+	IsActive *bool
+	Limit    int
+	Offset   int
+}
+
+// Reassignment описывает замену ревьюера на PR.
+// Пустой OldReviewerID означает первичное назначение,
+// пустой NewReviewerID — что кандидата на замену не нашлось.
+type Reassignment struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id,omitempty"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+}
+
+// Статусы пользователя в отчёте о массовой активации.
+const (
+	ActivationStatusActivated     = "activated"
+	ActivationStatusAlreadyActive = "already_active"
+	ActivationStatusNotFound      = "not_found"
+)
+
+// UserActivationResult описывает результат активации одного запрошенного пользователя
+// и ревью, переданные ему при ребалансировке.
+type UserActivationResult struct {
+	UserID          string         `json:"user_id"`
+	Status          string         `json:"status"` //[activated, already_active, not_found]
+	User            *User          `json:"user,omitempty"`
+	ReceivedReviews []Reassignment `json:"received_reviews"`
+}
+
+// ActivationReport — детальный отчёт о массовой активации.
+type ActivationReport struct {
+	TeamName     string                 `json:"team_name"`
+	Rebalance    bool                   `json:"rebalance"`
+	Users        []UserActivationResult `json:"users"`
+	MovedReviews []Reassignment         `json:"moved_reviews"`
+}
+
+// ChangePlan описывает изменения, внесённые операцией или запланированные в режиме dry-run.
+type ChangePlan struct {
+	DryRun        bool           `json:"dry_run"`
+	UsersAffected []string       `json:"users_affected"`
+	Reassignments []Reassignment `json:"reassignments"`
+	NoCandidate   []string       `json:"no_candidate"` // PR, оставшиеся без кандидата на замену
+}
+
+// NewChangePlan собирает план изменений и выделяет PR, оставшиеся без кандидата.
+func NewChangePlan(dryRun bool, users []string, reassignments []Reassignment) *ChangePlan {
+	plan := &ChangePlan{
+		DryRun:        dryRun,
+		UsersAffected: users,
+		Reassignments: reassignments,
+		NoCandidate:   []string{},
+	}
+	if plan.UsersAffected == nil {
+		plan.UsersAffected = []string{}
+	}
+	if plan.Reassignments == nil {
+		plan.Reassignments = []Reassignment{}
+	}
+	for _, r := range reassignments {
+		if r.NewReviewerID == "" {
+			plan.NoCandidate = append(plan.NoCandidate, r.PullRequestID)
+		}
+	}
+	return plan
+}
+
+// Политики массовой деактивации.
+const (
+	PolicyAllOrNothing = "all_or_nothing"
+	PolicyBestEffort   = "best_effort"
+)
+
+// Статусы пользователя в отчёте о массовой деактивации.
+const (
+	DeactivationStatusDeactivated     = "deactivated"
+	DeactivationStatusMissing         = "missing"
+	DeactivationStatusAlreadyInactive = "already_inactive"
+	DeactivationStatusFailed          = "failed"
+)
+
+// Исходы переназначения ревьюера на PR.
+const (
+	OutcomeReplaced    = "replaced"
+	OutcomeNoCandidate = "no_candidate"
+	OutcomeFailed      = "failed"
+)
+
+// UserDeactivationResult описывает результат деактивации одного запрошенного пользователя.
+type UserDeactivationResult struct {
+	UserID string `json:"user_id"`
+	Status string `json:"status"` //[deactivated, missing, already_inactive, failed]
+	Error  string `json:"error,omitempty"`
+	User   *User  `json:"user,omitempty"`
+}
+
+// PullRequestOutcome описывает исход переназначения ревьюера на затронутом PR.
+type PullRequestOutcome struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	Outcome       string `json:"outcome"` //[replaced, no_candidate, failed]
+	ReplacedBy    string `json:"replaced_by,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// DeactivationReport — детальный отчёт о массовой деактивации.
+type DeactivationReport struct {
+	TeamName     string                   `json:"team_name"`
+	Policy       string                   `json:"policy"`
+	DryRun       bool                     `json:"dry_run"`
+	Committed    bool                     `json:"committed"`
+	Users        []UserDeactivationResult `json:"users"`
+	PullRequests []PullRequestOutcome     `json:"pull_requests"`
+}
+
+// ExternalRef связывает PR с pull/merge request во внешней системе (GitHub, GitLab).
+type ExternalRef struct {
+	Provider   string `json:"provider"`
+	Repository string `json:"repository"`
+	Number     int    `json:"number"`
+	URL        string `json:"url,omitempty"`
+}
+
+// Типы событий, публикуемых store после коммита.
+const (
+	EventPRCreated        = "pr_created"
+	EventPRMerged         = "pr_merged"
+	EventPRClosed         = "pr_closed"
+	EventReviewerAssigned = "reviewer_assigned"
+	EventReviewerReplaced = "reviewer_replaced"
+	EventReviewerRemoved  = "reviewer_removed" // ревьюер снят без замены: кандидата не нашлось
+	EventUserActivated    = "user_activated"
+	EventUserDeactivated  = "user_deactivated"
+)
+
+// Причины переназначения ревьюера.
+const (
+	ReasonManual       = "manual"
+	ReasonDeactivation = "deactivation"
+	ReasonRebalance    = "rebalance"
+	ReasonSync         = "sync"
+)
+
+// Event — событие, сохраняемое в журнал и публикуемое store после коммита транзакции.
+type Event struct {
+	ID             int64     `json:"id"` // порядковый номер в журнале событий
+	Type           string    `json:"type"`
+	PullRequestID  string    `json:"pull_request_id,omitempty"`
+	UserID         string    `json:"user_id,omitempty"`          // назначенный ревьюер или затронутый пользователь
+	PreviousUserID string    `json:"previous_user_id,omitempty"` // заменённый или снятый ревьюер
+	TeamName       string    `json:"team_name,omitempty"`
+	Reason         string    `json:"reason,omitempty"`
+	Actor          string    `json:"actor,omitempty"` // инициатор изменения, пусто для вебхуков и фоновых задач
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// IsReviewerChange сообщает, меняет ли событие состав ревьюеров PR.
+func (e Event) IsReviewerChange() bool {
+	return e.Type == EventReviewerAssigned || e.Type == EventReviewerReplaced || e.Type == EventReviewerRemoved
+}
+
+// EventFilter задаёт выборку из журнала событий.
+type EventFilter struct {
+	AfterID  int64  // события с id больше указанного
+	TeamName string // события команды
+	UserID   string // события, затрагивающие пользователя
+	Limit    int
+}
+
+// Match сообщает, подходит ли событие под фильтр (без учёта AfterID и Limit).
+func (f EventFilter) Match(e Event) bool {
+	if f.TeamName != "" && e.TeamName != f.TeamName {
+		return false
+	}
+	if f.UserID != "" && e.UserID != f.UserID && e.PreviousUserID != f.UserID {
+		return false
+	}
+	return true
+}
+
+// Действия плана синхронизации команд.
+const (
+	SyncCreateUser     = "create"
+	SyncMoveUser       = "move"
+	SyncUpdateUser     = "update"
+	SyncActivateUser   = "activate"
+	SyncDeactivateUser = "deactivate"
+	SyncSetRules       = "set_rules"
+)
+
+// TeamSyncSpec — декларативное описание составов команд.
+type TeamSyncSpec struct {
+	Teams []TeamSpec `json:"teams"`
+}
+
+// TeamSpec описывает желаемое состояние команды. Участники команды, не перечисленные
+// в Members, деактивируются. ReviewRules == nil оставляет правила без изменений.
+type TeamSpec struct {
+	TeamName    string       `json:"team_name"`
+	Members     []MemberSpec `json:"members"`
+	ReviewRules []ReviewRule `json:"review_rules"`
+}
+
+// MemberSpec описывает желаемое состояние участника; IsActive по умолчанию true.
+type MemberSpec struct {
+	UserID     string     `json:"user_id"`
+	Username   string     `json:"username"`
+	IsActive   *bool      `json:"is_active,omitempty"`
+	Role       string     `json:"role,omitempty"`
+	Identities []Identity `json:"identities,omitempty"`
+}
+
+// Active возвращает желаемый флаг активности участника.
+func (m MemberSpec) Active() bool {
+	return m.IsActive == nil || *m.IsActive
+}
+
+// SyncChange — одно изменение плана синхронизации.
+type SyncChange struct {
+	Action   string `json:"action"` //[create, move, update, activate, deactivate, set_rules]
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id,omitempty"`
+	FromTeam string `json:"from_team,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+// SyncPlan — результат синхронизации команд: изменения и затронутые ревью.
+type SyncPlan struct {
+	DryRun        bool           `json:"dry_run"`
+	Changes       []SyncChange   `json:"changes"`
+	Reassignments []Reassignment `json:"reassignments"`
+	NoCandidate   []string       `json:"no_candidate"`
+}
+
+// Области доступа API-токенов. ScopeAdmin включает все остальные и управление токенами.
+const (
+	ScopeRead      = "read"
+	ScopePRWrite   = "pr:write"
+	ScopeTeamAdmin = "team:admin"
+	ScopeStats     = "stats"
+	ScopeAdmin     = "admin"
+)
+
+// IsValidScope сообщает, является ли область доступа допустимой.
+func IsValidScope(scope string) bool {
+	switch scope {
+	case ScopeRead, ScopePRWrite, ScopeTeamAdmin, ScopeStats, ScopeAdmin:
+		return true
+	}
+	return false
+}
+
+// APIToken — API-токен. Сам секрет не хранится, только его хэш.
+type APIToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// Active сообщает, действует ли токен в момент now.
+func (t *APIToken) Active(now time.Time) bool {
+	return t.RevokedAt == nil && (t.ExpiresAt == nil || now.Before(*t.ExpiresAt))
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseTeamSyncSpec разбирает описание команд в YAML или JSON.
+// YAML является надмножеством JSON, поэтому ключи в обоих форматах совпадают с json-тегами моделей.
+func ParseTeamSyncSpec(data []byte) (*TeamSyncSpec, error) {
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("parse team spec: %w", err)
+	}
+	normalized, err := json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("parse team spec: %w", err)
+	}
+	var spec TeamSyncSpec
+	if err := json.Unmarshal(normalized, &spec); err != nil {
+		return nil, fmt.Errorf("parse team spec: %w", err)
+	}
+	return &spec, nil
+}
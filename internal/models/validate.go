@@ -0,0 +1,120 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+)
+
+// ValidateRules проверяет роли и количества в правилах назначения. Правило
+// с min_count больше maxCount — числа ревьюеров на PR — невыполнимо.
+func ValidateRules(rules []ReviewRule, maxCount int) error {
+	seen := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		if !IsValidRole(r.Role) {
+			return fmt.Errorf("invalid rule role %q", r.Role)
+		}
+		if r.MinCount <= 0 {
+			return fmt.Errorf("min_count for role %s must be positive", r.Role)
+		}
+		if r.MinCount > maxCount {
+			return fmt.Errorf("min_count for role %s must not exceed %d reviewers per PR", r.Role, maxCount)
+		}
+		if seen[r.Role] {
+			return fmt.Errorf("duplicate rule for role %s", r.Role)
+		}
+		seen[r.Role] = true
+	}
+	return nil
+}
+
+// ValidateIdentities проверяет провайдеров и external_id, не допуская
+// двух записей одного провайдера у пользователя. Для email external_id
+// должен быть адресом без отображаемого имени: он подставляется в заголовок To.
+func ValidateIdentities(identities []Identity) error {
+	seen := make(map[string]bool, len(identities))
+	for _, i := range identities {
+		if !IsValidProvider(i.Provider) {
+			return fmt.Errorf("invalid identity provider %q", i.Provider)
+		}
+		if strings.TrimSpace(i.ExternalID) == "" {
+			return fmt.Errorf("external_id for provider %s is required", i.Provider)
+		}
+		if i.Provider == ProviderEmail {
+			addr, err := mail.ParseAddress(i.ExternalID)
+			if err != nil || addr.Name != "" || addr.Address != i.ExternalID {
+				return fmt.Errorf("external_id for provider email must be an email address, got %q", i.ExternalID)
+			}
+		}
+		if i.Provider == ProviderGitLab {
+			if id, err := strconv.Atoi(i.ExternalID); err != nil || id <= 0 {
+				return fmt.Errorf("external_id for provider gitlab must be a numeric user id, got %q", i.ExternalID)
+			}
+		}
+		if seen[i.Provider] {
+			return fmt.Errorf("duplicate identity for provider %s", i.Provider)
+		}
+		seen[i.Provider] = true
+	}
+	return nil
+}
+
+// Validate проверяет описание: команды и пользователи не повторяются,
+// роли, правила и внешние учётные записи допустимы. maxCount — число ревьюеров на PR.
+func (spec *TeamSyncSpec) Validate(maxCount int) error {
+	if len(spec.Teams) == 0 {
+		return errors.New("teams are required")
+	}
+	teams := make(map[string]bool, len(spec.Teams))
+	users := make(map[string]string)
+	for _, t := range spec.Teams {
+		if t.TeamName == "" {
+			return errors.New("team_name is required")
+		}
+		if teams[t.TeamName] {
+			return fmt.Errorf("duplicate team %s", t.TeamName)
+		}
+		teams[t.TeamName] = true
+
+		if err := ValidateRules(t.ReviewRules, maxCount); err != nil {
+			return fmt.Errorf("team %s: %w", t.TeamName, err)
+		}
+		for _, m := range t.Members {
+			if m.UserID == "" || m.Username == "" {
+				return fmt.Errorf("team %s: user_id and username are required", t.TeamName)
+			}
+			if other, ok := users[m.UserID]; ok {
+				return fmt.Errorf("user %s is listed in teams %s and %s", m.UserID, other, t.TeamName)
+			}
+			users[m.UserID] = t.TeamName
+			if m.Role != "" && !IsValidRole(m.Role) {
+				return fmt.Errorf("invalid role for user %s", m.UserID)
+			}
+			if err := ValidateIdentities(m.Identities); err != nil {
+				return fmt.Errorf("user %s: %w", m.UserID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateScopes проверяет, что список областей доступа не пуст и не содержит
+// неизвестных и повторяющихся значений.
+func ValidateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return errors.New("scopes are required")
+	}
+	seen := make(map[string]bool, len(scopes))
+	for _, s := range scopes {
+		if !IsValidScope(s) {
+			return fmt.Errorf("invalid scope %q", s)
+		}
+		if seen[s] {
+			return fmt.Errorf("duplicate scope %s", s)
+		}
+		seen[s] = true
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// defaultSMTPTimeout ограничивает отправку одного письма, включая подключение.
+const defaultSMTPTimeout = 30 * time.Second
+
+// EmailDirectory — данные store, нужные для писем и дайджеста.
+type EmailDirectory interface {
+	Directory
+	ListProviderIdentities(ctx context.Context, provider string) ([]models.Identity, error)
+	GetUserReviews(ctx context.Context, userID string) ([]models.PullRequest, error)
+}
+
+// Шаблоны писем по умолчанию.
+const (
+	DefaultSubjectTemplate = `Ревью PR {{.PullRequest}}`
+	DefaultDigestSubject   = `Открытые ревью: {{len .Reviews}}`
+	DefaultDigestTemplate  = `Ваши открытые ревью:
+{{range .Reviews}}- {{.PullRequestName}} ({{.PullRequestID}}), автор {{.AuthorID}}
+{{end}}`
+)
+
+// EmailConfig настраивает отправку писем через SMTP.
+type EmailConfig struct {
+	// Addr — адрес SMTP-сервера в виде host:port.
+	Addr string
+	// Username и Password включают PLAIN-аутентификацию.
+	Username string
+	Password string
+	From     string
+	// Timeout ограничивает отправку одного письма; по умолчанию defaultSMTPTimeout.
+	Timeout time.Duration
+
+	// Subject — шаблон темы письма о назначении.
+	Subject   string
+	Templates Templates
+
+	// DigestAt — время ежедневного дайджеста в формате HH:MM; пустое значение отключает дайджест.
+	DigestAt       string
+	DigestSubject  string
+	DigestTemplate string
+
+	QueueSize int
+}
+
+// DigestData — данные шаблона дайджеста.
+type DigestData struct {
+	UserID  string
+	Reviews []models.PullRequest
+}
+
+// Email отправляет письма о назначениях и ежедневный дайджест открытых ревью.
+// Реализует store.Listener.
+type Email struct {
+	queue
+	cfg           EmailConfig
+	dir           EmailDirectory
+	templates     *templates
+	subject       *template.Template
+	digestSubject *template.Template
+	digest        *template.Template
+	digestHour    int
+	digestMinute  int
+}
+
+// NewEmail создает SMTP-уведомитель.
+func NewEmail(cfg EmailConfig, dir EmailDirectory) (*Email, error) {
+	if cfg.Addr == "" || cfg.From == "" {
+		return nil, errors.New("smtp addr and from are required")
+	}
+	if cfg.Subject == "" {
+		cfg.Subject = DefaultSubjectTemplate
+	}
+	if cfg.DigestSubject == "" {
+		cfg.DigestSubject = DefaultDigestSubject
+	}
+	if cfg.DigestTemplate == "" {
+		cfg.DigestTemplate = DefaultDigestTemplate
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultSMTPTimeout
+	}
+
+	e := &Email{
+		queue: newQueue("email", cfg.QueueSize),
+		cfg:   cfg,
+		dir:   dir,
+	}
+
+	var err error
+	if e.templates, err = parseTemplates(cfg.Templates); err != nil {
+		return nil, err
+	}
+	if e.subject, err = template.New("subject").Parse(cfg.Subject); err != nil {
+		return nil, fmt.Errorf("parse subject template: %w", err)
+	}
+	if e.digestSubject, err = template.New("digest_subject").Parse(cfg.DigestSubject); err != nil {
+		return nil, fmt.Errorf("parse digest subject template: %w", err)
+	}
+	if e.digest, err = template.New("digest").Parse(cfg.DigestTemplate); err != nil {
+		return nil, fmt.Errorf("parse digest template: %w", err)
+	}
+	if cfg.DigestAt != "" {
+		t, err := time.Parse("15:04", cfg.DigestAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid digest time %q: %w", cfg.DigestAt, err)
+		}
+		e.digestHour, e.digestMinute = t.Hour(), t.Minute()
+	}
+	return e, nil
+}
+
+// Run обрабатывает очередь событий и, если настроен, рассылает дайджест до отмены контекста.
+func (e *Email) Run(ctx context.Context) {
+	if e.cfg.DigestAt != "" {
+		go e.runDigest(ctx)
+	}
+	e.run(ctx, e.notify)
+}
+
+func (e *Email) notify(ctx context.Context, ev models.Event) error {
+	to, err := e.dir.GetIdentity(ctx, ev.UserID, models.ProviderEmail)
+	if errors.Is(err, store.ErrNotFound) {
+		// Адрес не привязан — письмо отправить некуда
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get email of %s: %w", ev.UserID, err)
+	}
+
+	data := MessageData{
+		Event:       ev,
+		Reviewer:    ev.UserID,
+		Previous:    ev.PreviousUserID,
+		PullRequest: ev.PullRequestID,
+		TeamName:    ev.TeamName,
+		Reason:      ev.Reason,
+	}
+	if ref, err := e.dir.GetExternalRef(ctx, ev.PullRequestID); err == nil {
+		data.URL = ref.URL
+	}
+
+	body, err := e.templates.render(data)
+	if err != nil {
+		return err
+	}
+	if data.URL != "" {
+		body += "\n\n" + data.URL
+	}
+	subject, err := execute(e.subject, data)
+	if err != nil {
+		return err
+	}
+	return e.sendMail(ctx, to.ExternalID, subject, body)
+}
+
+// runDigest отправляет дайджест каждый день в DigestAt по локальному времени.
+func (e *Email) runDigest(ctx context.Context) {
+	for {
+		timer := time.NewTimer(time.Until(e.nextDigest(time.Now())))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			if err := e.SendDigest(ctx); err != nil {
+				slog.ErrorContext(ctx, "Failed to send review digest", "error", err)
+			}
+		}
+	}
+}
+
+// nextDigest возвращает ближайший после now момент отправки дайджеста.
+func (e *Email) nextDigest(now time.Time) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), e.digestHour, e.digestMinute, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// SendDigest отправляет каждому активному пользователю с привязанным адресом
+// список его открытых ревью. Пользователи без открытых ревью пропускаются.
+func (e *Email) SendDigest(ctx context.Context) error {
+	identities, err := e.dir.ListProviderIdentities(ctx, models.ProviderEmail)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, identity := range identities {
+		prs, err := e.dir.GetUserReviews(ctx, identity.UserID)
+		if err != nil {
+			return err
+		}
+		data := DigestData{UserID: identity.UserID}
+		for _, pr := range prs {
+			if pr.Status == "OPEN" {
+				data.Reviews = append(data.Reviews, pr)
+			}
+		}
+		if len(data.Reviews) == 0 {
+			continue
+		}
+
+		subject, err := execute(e.digestSubject, data)
+		if err != nil {
+			return err
+		}
+		body, err := execute(e.digest, data)
+		if err != nil {
+			return err
+		}
+		if err := e.sendMail(ctx, identity.ExternalID, subject, body); err != nil {
+			slog.ErrorContext(ctx, "Failed to send digest", "user_id", identity.UserID, "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("digest not delivered to %d users", failed)
+	}
+	return nil
+}
+
+// sendMail отправляет письмо. Подключение и весь обмен с сервером ограничены
+// cfg.Timeout и отменой ctx, чтобы зависший сервер не останавливал воркер.
+func (e *Email) sendMail(ctx context.Context, to, subject, body string) error {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	host, _, err := net.SplitHostPort(e.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("invalid smtp addr: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.cfg.Timeout)
+	defer cancel()
+	dialer := net.Dialer{Timeout: e.cfg.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", e.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("connect to smtp server: %w", err)
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return fmt.Errorf("set smtp deadline: %w", err)
+	}
+	// Отмена ctx (остановка сервиса) прерывает обмен, не дожидаясь дедлайна
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer c.Close()
+
+	if err := e.deliver(c, host, to, msg.Bytes()); err != nil {
+		return fmt.Errorf("send mail to %s: %w", to, err)
+	}
+	return nil
+}
+
+// deliver выполняет обмен SMTP так же, как smtp.SendMail: STARTTLS, если сервер
+// его поддерживает, аутентификация и передача письма.
+func (e *Email) deliver(c *smtp.Client, host, to string, msg []byte) error {
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if e.cfg.Username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support AUTH")
+		}
+		if err := c.Auth(smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(e.cfg.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
@@ -0,0 +1,260 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/store"
+)
+
+// fakeDirectory — данные store для тестов уведомителей.
+type fakeDirectory struct {
+	identities map[string]map[string]string // provider -> user_id -> external_id
+	refs       map[string]models.ExternalRef
+	reviews    map[string][]models.PullRequest
+	err        error
+}
+
+func (d *fakeDirectory) GetExternalRef(_ context.Context, prID string) (*models.ExternalRef, error) {
+	ref, ok := d.refs[prID]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return &ref, nil
+}
+
+func (d *fakeDirectory) GetIdentity(_ context.Context, userID, provider string) (*models.Identity, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	id, ok := d.identities[provider][userID]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return &models.Identity{UserID: userID, Provider: provider, ExternalID: id}, nil
+}
+
+func (d *fakeDirectory) ListProviderIdentities(_ context.Context, provider string) ([]models.Identity, error) {
+	var list []models.Identity
+	for userID, id := range d.identities[provider] {
+		list = append(list, models.Identity{UserID: userID, Provider: provider, ExternalID: id})
+	}
+	return list, nil
+}
+
+func (d *fakeDirectory) GetUserReviews(_ context.Context, userID string) ([]models.PullRequest, error) {
+	return d.reviews[userID], nil
+}
+
+// mail — письмо, принятое fakeSMTP.
+type mail struct {
+	from, to string
+	data     string
+}
+
+// fakeSMTP — минимальный SMTP-сервер на net.Listen, принимающий письма без TLS и аутентификации.
+type fakeSMTP struct {
+	addr string
+
+	mu    sync.Mutex
+	mails []mail
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &fakeSMTP{addr: ln.Addr().String()}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	var m mail
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimRight(line, "\r\n")
+		switch upper := strings.ToUpper(cmd); {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			reply("250-localhost")
+			reply("250 8BITMIME")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			m = mail{from: strings.Trim(cmd[len("MAIL FROM:"):], "<> ")}
+			if i := strings.Index(m.from, ">"); i >= 0 {
+				m.from = m.from[:i]
+			}
+			reply("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			m.to = strings.Trim(cmd[len("RCPT TO:"):], "<> ")
+			reply("250 OK")
+		case upper == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			m.data = data.String()
+			s.mu.Lock()
+			s.mails = append(s.mails, m)
+			s.mu.Unlock()
+			reply("250 OK")
+		case upper == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func (s *fakeSMTP) received() []mail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]mail(nil), s.mails...)
+}
+
+func newTestEmail(t *testing.T, addr string, dir EmailDirectory) *Email {
+	t.Helper()
+	e, err := NewEmail(EmailConfig{Addr: addr, From: "reviews@example.com", Timeout: 2 * time.Second}, dir)
+	if err != nil {
+		t.Fatalf("NewEmail() error = %v", err)
+	}
+	return e
+}
+
+func TestEmailNotify(t *testing.T) {
+	srv := newFakeSMTP(t)
+	dir := &fakeDirectory{
+		identities: map[string]map[string]string{models.ProviderEmail: {"u2": "bob@example.com"}},
+		refs:       map[string]models.ExternalRef{"pr-1": {URL: "https://git.example.com/pr/1"}},
+	}
+	e := newTestEmail(t, srv.addr, dir)
+
+	ev := models.Event{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "u2", TeamName: "backend"}
+	if err := e.notify(context.Background(), ev); err != nil {
+		t.Fatalf("notify() error = %v", err)
+	}
+	// Пользователь без адреса пропускается
+	if err := e.notify(context.Background(), models.Event{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "u3"}); err != nil {
+		t.Fatalf("notify() without address error = %v", err)
+	}
+
+	mails := srv.received()
+	if len(mails) != 1 {
+		t.Fatalf("received %d mails, want 1", len(mails))
+	}
+	m := mails[0]
+	if m.from != "reviews@example.com" || m.to != "bob@example.com" {
+		t.Errorf("envelope = %s -> %s", m.from, m.to)
+	}
+	for _, want := range []string{"To: bob@example.com", "u2 назначен ревьюером PR pr-1", "https://git.example.com/pr/1"} {
+		if !strings.Contains(m.data, want) {
+			t.Errorf("mail does not contain %q:\n%s", want, m.data)
+		}
+	}
+}
+
+func TestEmailNotifyDirectoryError(t *testing.T) {
+	e := newTestEmail(t, "127.0.0.1:1", &fakeDirectory{err: errors.New("db is down")})
+	ev := models.Event{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "u2"}
+	if err := e.notify(context.Background(), ev); err == nil {
+		t.Error("notify() error = nil, want directory error")
+	}
+}
+
+func TestEmailDigest(t *testing.T) {
+	srv := newFakeSMTP(t)
+	dir := &fakeDirectory{
+		identities: map[string]map[string]string{models.ProviderEmail: {
+			"u2": "bob@example.com",
+			"u3": "carol@example.com",
+		}},
+		reviews: map[string][]models.PullRequest{
+			"u2": {
+				{PullRequestID: "pr-1", PullRequestName: "Add export", AuthorID: "u1", Status: "OPEN"},
+				{PullRequestID: "pr-2", PullRequestName: "Old fix", AuthorID: "u1", Status: "MERGED"},
+			},
+			"u3": {{PullRequestID: "pr-3", PullRequestName: "Done", AuthorID: "u1", Status: "MERGED"}},
+		},
+	}
+	e := newTestEmail(t, srv.addr, dir)
+
+	if err := e.SendDigest(context.Background()); err != nil {
+		t.Fatalf("SendDigest() error = %v", err)
+	}
+	mails := srv.received()
+	if len(mails) != 1 {
+		t.Fatalf("received %d mails, want 1 (users without open reviews are skipped)", len(mails))
+	}
+	m := mails[0]
+	if m.to != "bob@example.com" {
+		t.Errorf("digest sent to %s, want bob@example.com", m.to)
+	}
+	if !strings.Contains(m.data, "Add export (pr-1)") || strings.Contains(m.data, "pr-2") {
+		t.Errorf("digest should list only open reviews:\n%s", m.data)
+	}
+}
+
+func TestEmailTimeout(t *testing.T) {
+	// Сервер принимает соединение, но не отвечает
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	e, err := NewEmail(EmailConfig{Addr: ln.Addr().String(), From: "reviews@example.com", Timeout: 200 * time.Millisecond}, &fakeDirectory{})
+	if err != nil {
+		t.Fatalf("NewEmail() error = %v", err)
+	}
+	start := time.Now()
+	if err := e.sendMail(context.Background(), "bob@example.com", "subject", "body"); err == nil {
+		t.Fatal("sendMail() error = nil, want timeout")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("sendMail() took %s, want about the configured timeout", elapsed)
+	}
+}
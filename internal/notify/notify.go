@@ -0,0 +1,149 @@
+// Package notify рассылает уведомления ревьюерам о событиях назначения.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"text/template"
+
+	"github.com/2Empty/review-assigner/internal/models"
+)
+
+// Directory — данные store, нужные для оформления уведомлений.
+type Directory interface {
+	GetExternalRef(ctx context.Context, prID string) (*models.ExternalRef, error)
+	GetIdentity(ctx context.Context, userID, provider string) (*models.Identity, error)
+}
+
+// Templates — шаблоны text/template для событий назначения.
+// Пустое поле заменяется шаблоном по умолчанию.
+type Templates struct {
+	Assigned string
+	Replaced string
+}
+
+// Шаблоны сообщений по умолчанию.
+const (
+	DefaultAssignedTemplate = `{{.Reviewer}} назначен ревьюером PR {{.PullRequest}}`
+	DefaultReplacedTemplate = `{{.Reviewer}} заменяет {{.Previous}} в ревью PR {{.PullRequest}} ({{.Reason}})`
+)
+
+// MessageData — данные, доступные в шаблонах.
+type MessageData struct {
+	Event models.Event
+	// Reviewer и Previous — упоминания ревьюеров в формате канала доставки.
+	Reviewer string
+	Previous string
+	// PullRequest — ссылка на PR во внешней системе или его идентификатор.
+	PullRequest string
+	URL         string
+	TeamName    string
+	Reason      string
+}
+
+// templates — разобранные шаблоны событий.
+type templates struct {
+	assigned *template.Template
+	replaced *template.Template
+}
+
+func parseTemplates(t Templates) (*templates, error) {
+	if t.Assigned == "" {
+		t.Assigned = DefaultAssignedTemplate
+	}
+	if t.Replaced == "" {
+		t.Replaced = DefaultReplacedTemplate
+	}
+
+	assigned, err := template.New("assigned").Parse(t.Assigned)
+	if err != nil {
+		return nil, fmt.Errorf("parse assigned template: %w", err)
+	}
+	replaced, err := template.New("replaced").Parse(t.Replaced)
+	if err != nil {
+		return nil, fmt.Errorf("parse replaced template: %w", err)
+	}
+	return &templates{assigned: assigned, replaced: replaced}, nil
+}
+
+// render выполняет шаблон, соответствующий типу события.
+func (t *templates) render(data MessageData) (string, error) {
+	tmpl := t.assigned
+	if data.Event.Type == models.EventReviewerReplaced {
+		tmpl = t.replaced
+	}
+
+	return execute(tmpl, data)
+}
+
+// execute выполняет шаблон и возвращает результат строкой.
+func execute(t *template.Template, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("render %s: %w", t.Name(), err)
+	}
+	return buf.String(), nil
+}
+
+// queue — очередь событий, реализующая store.Listener.
+// Обработка выполняется в Run, чтобы не задерживать транзакции store.
+type queue struct {
+	name   string
+	events chan []models.Event
+}
+
+func newQueue(name string, size int) queue {
+	if size <= 0 {
+		size = 256
+	}
+	return queue{name: name, events: make(chan []models.Event, size)}
+}
+
+// HandleEvents ставит события в очередь; при переполнении они отбрасываются.
+func (q queue) HandleEvents(events []models.Event) {
+	select {
+	case q.events <- events:
+	default:
+		slog.Warn("Notification queue is full, dropping events", "channel", q.name, "events", len(events))
+	}
+}
+
+// run вызывает handle для каждого события до отмены контекста.
+func (q queue) run(ctx context.Context, handle func(context.Context, models.Event) error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case events := <-q.events:
+			for _, e := range events {
+				// Снятие ревьюера без замены никому не адресовано
+				if !e.IsReviewerChange() || e.UserID == "" {
+					continue
+				}
+				if err := handle(ctx, e); err != nil {
+					slog.ErrorContext(ctx, "Failed to send notification", "channel", q.name, "pr_id", e.PullRequestID, "user_id", e.UserID, "team", e.TeamName, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// ParseMapping разбирает строку вида "backend=#backend,payments=C0123" в словарь.
+func ParseMapping(s string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(value) == "" {
+			return nil, fmt.Errorf("invalid mapping entry %q", pair)
+		}
+		result[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return result, nil
+}
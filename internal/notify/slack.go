@@ -0,0 +1,182 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+)
+
+// SlackConfig настраивает отправку сообщений в Slack-совместимый чат.
+type SlackConfig struct {
+	// URL — адрес incoming webhook либо метода chat.postMessage.
+	URL string
+	// Token задаётся для chat.postMessage; без него URL считается incoming webhook.
+	Token string
+	// DefaultChannel используется для команд без записи в Channels.
+	DefaultChannel string
+	// Channels сопоставляет команду с каналом.
+	Channels map[string]string
+	// DirectMessages включает личные сообщения ревьюерам с привязанным Slack id.
+	// Требует Token: incoming webhook игнорирует channel и публикует в свой канал.
+	DirectMessages bool
+	Templates      Templates
+	QueueSize      int
+}
+
+// slackEscaper экранирует управляющие символы разметки Slack в подставляемых
+// именах, чтобы они не превращались в ссылки и упоминания.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// slackMessage — общее подмножество payload incoming webhook и chat.postMessage.
+type slackMessage struct {
+	Channel string `json:"channel,omitempty"`
+	Text    string `json:"text"`
+}
+
+// slackResponse — ответ chat.postMessage.
+type slackResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// Slack отправляет уведомления о назначениях в каналы команд и в личные сообщения.
+// Реализует store.Listener.
+type Slack struct {
+	queue
+	cfg        SlackConfig
+	dir        Directory
+	templates  *templates
+	HTTPClient *http.Client
+}
+
+// NewSlack создает Slack-уведомитель.
+func NewSlack(cfg SlackConfig, dir Directory) (*Slack, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("slack url is required")
+	}
+	if cfg.DirectMessages && cfg.Token == "" {
+		return nil, errors.New("slack token is required for direct messages")
+	}
+	t, err := parseTemplates(cfg.Templates)
+	if err != nil {
+		return nil, err
+	}
+	return &Slack{
+		queue:      newQueue("slack", cfg.QueueSize),
+		cfg:        cfg,
+		dir:        dir,
+		templates:  t,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Run обрабатывает очередь событий до отмены контекста.
+func (s *Slack) Run(ctx context.Context) {
+	s.run(ctx, s.notify)
+}
+
+func (s *Slack) notify(ctx context.Context, e models.Event) error {
+	data := MessageData{
+		Event:       e,
+		Reviewer:    s.mention(ctx, e.UserID),
+		Previous:    s.mention(ctx, e.PreviousUserID),
+		PullRequest: slackEscaper.Replace(e.PullRequestID),
+		TeamName:    slackEscaper.Replace(e.TeamName),
+		Reason:      slackEscaper.Replace(e.Reason),
+	}
+	if ref, err := s.dir.GetExternalRef(ctx, e.PullRequestID); err == nil {
+		data.URL = ref.URL
+		if ref.URL != "" {
+			data.PullRequest = fmt.Sprintf("<%s|%s>", ref.URL, slackEscaper.Replace(e.PullRequestID))
+		}
+	}
+
+	text, err := s.templates.render(data)
+	if err != nil {
+		return err
+	}
+
+	// Сообщение в канал и личное сообщение отправляются независимо:
+	// ошибка одного не отменяет другое
+	var errs []error
+	channel := s.cfg.Channels[e.TeamName]
+	if channel == "" {
+		channel = s.cfg.DefaultChannel
+	}
+	if channel != "" || s.cfg.Token == "" {
+		if err := s.post(ctx, slackMessage{Channel: channel, Text: text}); err != nil {
+			errs = append(errs, fmt.Errorf("channel: %w", err))
+		}
+	}
+
+	if s.cfg.DirectMessages && s.cfg.Token != "" {
+		// Без Slack id личное сообщение отправить некуда
+		if identity, err := s.dir.GetIdentity(ctx, e.UserID, models.ProviderSlack); err == nil {
+			if err := s.post(ctx, slackMessage{Channel: identity.ExternalID, Text: text}); err != nil {
+				errs = append(errs, fmt.Errorf("direct message: %w", err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// mention возвращает упоминание пользователя: <@id> при наличии Slack id, иначе user_id.
+func (s *Slack) mention(ctx context.Context, userID string) string {
+	if userID == "" {
+		return ""
+	}
+	identity, err := s.dir.GetIdentity(ctx, userID, models.ProviderSlack)
+	if err != nil {
+		return slackEscaper.Replace(userID)
+	}
+	return "<@" + identity.ExternalID + ">"
+}
+
+func (s *Slack) post(ctx context.Context, msg slackMessage) error {
+	// Разметка Slack (<@id>, <url|text>) не должна экранироваться
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(msg); err != nil {
+		return fmt.Errorf("encode message: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, &body)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if s.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.cfg.Token)
+	}
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("post message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("post message: %d %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	// chat.postMessage сообщает об ошибках в теле ответа со статусом 200
+	if s.cfg.Token != "" {
+		var r slackResponse
+		if err := json.Unmarshal(respBody, &r); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+		if !r.OK {
+			return fmt.Errorf("post message: %s", r.Error)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/2Empty/review-assigner/internal/models"
+)
+
+// slackRequest — запрос, принятый заглушкой Slack.
+type slackRequest struct {
+	Authorization string
+	Payload       map[string]any
+}
+
+// newSlackStub запускает заглушку Slack, отвечающую response на каждый запрос.
+func newSlackStub(t *testing.T, response string) (*httptest.Server, func() []slackRequest) {
+	t.Helper()
+	var mu sync.Mutex
+	var requests []slackRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		requests = append(requests, slackRequest{Authorization: r.Header.Get("Authorization"), Payload: payload})
+		mu.Unlock()
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() []slackRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]slackRequest(nil), requests...)
+	}
+}
+
+func TestSlackWebhook(t *testing.T) {
+	srv, requests := newSlackStub(t, "ok")
+	s, err := NewSlack(SlackConfig{URL: srv.URL}, &fakeDirectory{
+		identities: map[string]map[string]string{models.ProviderSlack: {"u2": "U02"}},
+	})
+	if err != nil {
+		t.Fatalf("NewSlack() error = %v", err)
+	}
+
+	ev := models.Event{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "u2", TeamName: "backend"}
+	if err := s.notify(context.Background(), ev); err != nil {
+		t.Fatalf("notify() error = %v", err)
+	}
+
+	got := requests()
+	if len(got) != 1 {
+		t.Fatalf("got %d requests, want 1", len(got))
+	}
+	// Incoming webhook публикует в свой канал: channel и токен не передаются
+	if got[0].Authorization != "" {
+		t.Errorf("Authorization = %q, want empty", got[0].Authorization)
+	}
+	if _, ok := got[0].Payload["channel"]; ok {
+		t.Errorf("payload has channel: %v", got[0].Payload)
+	}
+	if text := got[0].Payload["text"]; text != "<@U02> назначен ревьюером PR pr-1" {
+		t.Errorf("text = %q", text)
+	}
+}
+
+func TestSlackPostMessage(t *testing.T) {
+	srv, requests := newSlackStub(t, `{"ok":true}`)
+	s, err := NewSlack(SlackConfig{
+		URL:            srv.URL,
+		Token:          "xoxb-test",
+		DefaultChannel: "#reviews",
+		Channels:       map[string]string{"backend": "C0BACK"},
+		DirectMessages: true,
+	}, &fakeDirectory{
+		identities: map[string]map[string]string{models.ProviderSlack: {"u2": "U02"}},
+		refs:       map[string]models.ExternalRef{"pr-1": {URL: "https://git.example.com/pr/1"}},
+	})
+	if err != nil {
+		t.Fatalf("NewSlack() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		event    models.Event
+		channels []string
+	}{
+		{"mapped team with DM", models.Event{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "u2", TeamName: "backend"}, []string{"C0BACK", "U02"}},
+		{"default channel without Slack id", models.Event{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "u3", TeamName: "payments"}, []string{"#reviews"}},
+	}
+	seen := 0
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.notify(context.Background(), tt.event); err != nil {
+				t.Fatalf("notify() error = %v", err)
+			}
+			got := requests()[seen:]
+			seen += len(got)
+			if len(got) != len(tt.channels) {
+				t.Fatalf("got %d requests, want %d", len(got), len(tt.channels))
+			}
+			for i, r := range got {
+				if r.Authorization != "Bearer xoxb-test" {
+					t.Errorf("Authorization = %q", r.Authorization)
+				}
+				if r.Payload["channel"] != tt.channels[i] {
+					t.Errorf("channel = %v, want %s", r.Payload["channel"], tt.channels[i])
+				}
+			}
+		})
+	}
+
+	if text := requests()[0].Payload["text"]; text != "<@U02> назначен ревьюером PR <https://git.example.com/pr/1|pr-1>" {
+		t.Errorf("text = %q", text)
+	}
+}
+
+func TestSlackPostMessageError(t *testing.T) {
+	srv, _ := newSlackStub(t, `{"ok":false,"error":"channel_not_found"}`)
+	s, err := NewSlack(SlackConfig{URL: srv.URL, Token: "xoxb-test", DefaultChannel: "#missing"}, &fakeDirectory{})
+	if err != nil {
+		t.Fatalf("NewSlack() error = %v", err)
+	}
+	ev := models.Event{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "u2"}
+	if err := s.notify(context.Background(), ev); err == nil {
+		t.Error("notify() error = nil, want channel_not_found")
+	}
+}
+
+func TestSlackEscapesNames(t *testing.T) {
+	srv, requests := newSlackStub(t, "ok")
+	s, err := NewSlack(SlackConfig{URL: srv.URL}, &fakeDirectory{})
+	if err != nil {
+		t.Fatalf("NewSlack() error = %v", err)
+	}
+
+	ev := models.Event{Type: models.EventReviewerAssigned, PullRequestID: "<!channel> & co", UserID: "<@U999>"}
+	if err := s.notify(context.Background(), ev); err != nil {
+		t.Fatalf("notify() error = %v", err)
+	}
+	want := "&lt;@U999&gt; назначен ревьюером PR &lt;!channel&gt; &amp; co"
+	if text := requests()[0].Payload["text"]; text != want {
+		t.Errorf("text = %q, want %q", text, want)
+	}
+}
+
+func TestNewSlackRequiresTokenForDirectMessages(t *testing.T) {
+	if _, err := NewSlack(SlackConfig{URL: "http://example.com", DirectMessages: true}, &fakeDirectory{}); err == nil {
+		t.Error("NewSlack() error = nil, want token required")
+	}
+}
@@ -0,0 +1,284 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// openReview — открытый PR с назначенными ревьюерами, используемый при ребалансировке.
+type openReview struct {
+	PullRequestID string
+	AuthorID      string
+	Reviewers     []string
+}
+
+// ActivateTeamUsers выполняет массовую активацию участников команды.
+// Если список userIDs пуст, будут активированы все неактивные участники команды.
+// При rebalance часть открытых ревью перегруженных участников передаётся активированным.
+// Отчёт содержит статус каждого запрошенного пользователя; ErrTeamNotFound возвращается
+// только при отсутствии команды.
+func (s *Store) ActivateTeamUsers(ctx context.Context, teamName string, userIDs []string, rebalance bool) (*models.ActivationReport, error) {
+	ctx, span := startOp(ctx, "store.ActivateTeamUsers", attribute.String("team", teamName), attribute.Int("users", len(userIDs)))
+	defer span.End()
+
+	if teamName == "" {
+		return nil, fmt.Errorf("activate team users: team name is required")
+	}
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	err = s.lockTeamByName(ctx, tx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("activate team users: %w", err)
+	}
+
+	userState, err := getTeamUserStatesInTx(ctx, tx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("activate team users: %w", err)
+	}
+
+	requested := uniqueStrings(userIDs)
+	if len(userIDs) == 0 {
+		for id, active := range userState {
+			if !active {
+				requested = append(requested, id)
+			}
+		}
+		sort.Strings(requested)
+	}
+
+	report := &models.ActivationReport{
+		TeamName:     teamName,
+		Rebalance:    rebalance,
+		Users:        make([]models.UserActivationResult, 0, len(requested)),
+		MovedReviews: []models.Reassignment{},
+	}
+
+	var activated []string
+	for _, id := range requested {
+		active, ok := userState[id]
+		switch {
+		case !ok:
+			report.Users = append(report.Users, models.UserActivationResult{UserID: id, Status: models.ActivationStatusNotFound, ReceivedReviews: []models.Reassignment{}})
+			continue
+		case active:
+			report.Users = append(report.Users, models.UserActivationResult{UserID: id, Status: models.ActivationStatusAlreadyActive, ReceivedReviews: []models.Reassignment{}})
+			continue
+		}
+
+		var user models.User
+		err := tx.QueryRow(ctx, `
+			UPDATE users
+			SET is_active = true
+			WHERE user_id = $1
+			RETURNING user_id, username, team_name, is_active, role`,
+			id).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Role)
+		if err != nil {
+			return nil, fmt.Errorf("activate user %s: %w", id, err)
+		}
+		activated = append(activated, id)
+		report.Users = append(report.Users, models.UserActivationResult{
+			UserID:          id,
+			Status:          models.ActivationStatusActivated,
+			User:            &user,
+			ReceivedReviews: []models.Reassignment{},
+		})
+	}
+
+	if rebalance && len(activated) > 0 {
+		moves, err := rebalanceTeamInTx(ctx, tx, teamName, activated)
+		if err != nil {
+			return nil, fmt.Errorf("rebalance team %s: %w", teamName, err)
+		}
+		for i := range report.Users {
+			for _, m := range moves {
+				if m.NewReviewerID == report.Users[i].UserID {
+					report.Users[i].ReceivedReviews = append(report.Users[i].ReceivedReviews, m)
+				}
+			}
+		}
+		report.MovedReviews = append(report.MovedReviews, moves...)
+	}
+
+	events := make([]models.Event, 0, len(activated)+len(report.MovedReviews))
+	for _, u := range report.Users {
+		if u.User != nil {
+			events = append(events, userEvent(u.User, models.ReasonManual))
+		}
+	}
+	events = append(events, replacedEvents(report.MovedReviews, teamName, models.ReasonRebalance)...)
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// rebalanceTeamInTx переносит открытые ревью от наиболее загруженных участников
+// команды к получателям, пока нагрузка получателей не достигнет средней по команде.
+// Переносы, ухудшающие выполнение правил ролей, пропускаются.
+func rebalanceTeamInTx(ctx context.Context, tx pgx.Tx, teamName string, receivers []string) ([]models.Reassignment, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT user_id, role
+		FROM users
+		WHERE team_name = $1 AND is_active = true
+		ORDER BY user_id`,
+		teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get active members: %w", err)
+	}
+	members, err := scanCandidates(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	roles := make(map[string]string, len(members))
+	memberIDs := make([]string, 0, len(members))
+	for _, m := range members {
+		roles[m.UserID] = m.Role
+		memberIDs = append(memberIDs, m.UserID)
+	}
+
+	reviews, err := getOpenReviewsInTx(ctx, tx, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := getTeamRules(ctx, tx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	load := make(map[string]int, len(members))
+	total := 0
+	for _, r := range reviews {
+		for _, id := range r.Reviewers {
+			if _, ok := roles[id]; ok {
+				load[id]++
+				total++
+			}
+		}
+	}
+	target := total / len(members)
+
+	var moves []models.Reassignment
+	for _, receiver := range receivers {
+		if _, ok := roles[receiver]; !ok {
+			continue
+		}
+		for load[receiver] < target {
+			move, ok := findMove(reviews, receiver, memberIDs, load, target, roles, rules)
+			if !ok {
+				break
+			}
+			review := &reviews[move.index]
+			review.Reviewers = replaceInSlice(review.Reviewers, move.from, receiver)
+
+			_, err := tx.Exec(ctx, `
+				UPDATE pull_requests
+				SET assigned_reviewers = $1
+				WHERE pull_request_id = $2`,
+				review.Reviewers, review.PullRequestID)
+			if err != nil {
+				return nil, fmt.Errorf("update reviewers: %w", err)
+			}
+
+			load[move.from]--
+			load[receiver]++
+			moves = append(moves, models.Reassignment{
+				PullRequestID: review.PullRequestID,
+				OldReviewerID: move.from,
+				NewReviewerID: receiver,
+			})
+		}
+	}
+
+	return moves, nil
+}
+
+type reviewMove struct {
+	index int
+	from  string
+}
+
+// findMove ищет ревью самого загруженного участника, которое можно передать получателю.
+func findMove(reviews []openReview, receiver string, memberIDs []string, load map[string]int,
+	target int, roles map[string]string, rules []models.ReviewRule) (reviewMove, bool) {
+	donors := make([]string, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		if id != receiver && load[id] > target {
+			donors = append(donors, id)
+		}
+	}
+	sort.SliceStable(donors, func(i, j int) bool { return load[donors[i]] > load[donors[j]] })
+
+	for _, donor := range donors {
+		for i, r := range reviews {
+			if r.AuthorID == receiver || !contains(r.Reviewers, donor) || contains(r.Reviewers, receiver) {
+				continue
+			}
+			if len(rules) > 0 {
+				before := checkRules(toCandidates(r.Reviewers, roles), rules)
+				after := checkRules(toCandidates(replaceInSlice(r.Reviewers, donor, receiver), roles), rules)
+				if len(after) > len(before) {
+					continue
+				}
+			}
+			return reviewMove{index: i, from: donor}, true
+		}
+	}
+	return reviewMove{}, false
+}
+
+func toCandidates(ids []string, roles map[string]string) []candidate {
+	result := make([]candidate, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, candidate{UserID: id, Role: roles[id]})
+	}
+	return result
+}
+
+// getOpenReviewsInTx возвращает открытые PR, где ревьюером назначен кто-либо из userIDs.
+func getOpenReviewsInTx(ctx context.Context, tx pgx.Tx, userIDs []string) ([]openReview, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT pull_request_id, author_id, assigned_reviewers
+		FROM pull_requests
+		WHERE status = 'OPEN' AND assigned_reviewers ?| $1
+		ORDER BY created_at, pull_request_id
+		FOR UPDATE`,
+		userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get open reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []openReview
+	for rows.Next() {
+		var r openReview
+		if err := rows.Scan(&r.PullRequestID, &r.AuthorID, &r.Reviewers); err != nil {
+			return nil, fmt.Errorf("scan open review: %w", err)
+		}
+		reviews = append(reviews, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SchemaVersion — версия схемы (номер последней миграции), которую ожидает код.
+const SchemaVersion = 9
+
+// Значения по умолчанию для подключения к базе.
+const (
+	defaultConnectTimeout    = time.Minute
+	defaultConnectMinBackoff = 500 * time.Millisecond
+	defaultConnectMaxBackoff = 10 * time.Second
+	defaultPingInterval      = 5 * time.Second
+	pingTimeout              = 3 * time.Second
+)
+
+// connect проверяет соединение с базой, повторяя попытки с экспоненциальной
+// задержкой, пока не истечёт timeout или не будет отменён ctx.
+func connect(ctx context.Context, pool *pgxpool.Pool, timeout, minBackoff, maxBackoff time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := minBackoff
+	for attempt := 1; ; attempt++ {
+		err := ping(ctx, pool)
+		if err == nil {
+			if attempt > 1 {
+				slog.InfoContext(ctx, "Connected to database", "attempts", attempt)
+			}
+			return nil
+		}
+
+		deadline, _ := ctx.Deadline()
+		if time.Until(deadline) < backoff {
+			return fmt.Errorf("database is not reachable after %d attempts within %s: %w", attempt, timeout, err)
+		}
+		slog.WarnContext(ctx, "Database is not ready, retrying", "attempt", attempt, "retry_in", backoff, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database is not reachable after %d attempts: %w", attempt, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+func ping(ctx context.Context, pool *pgxpool.Pool) error {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	return pool.Ping(ctx)
+}
+
+// Ping проверяет соединение с базой.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.Pool.Ping(ctx)
+}
+
+// CheckSchema сверяет версию схемы в базе с SchemaVersion.
+func (s *Store) CheckSchema(ctx context.Context) error {
+	var version int
+	err := s.Pool.QueryRow(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+	if err != nil {
+		return fmt.Errorf("get schema version: %w", err)
+	}
+	if version < SchemaVersion {
+		return fmt.Errorf("schema version %d is older than required %d", version, SchemaVersion)
+	}
+	return nil
+}
+
+// Monitor периодически проверяет соединение с базой и пишет в лог переходы
+// состояния до отмены контекста. Разорванные соединения пул pgx пересоздаёт сам,
+// поэтому после возвращения базы сервис восстанавливается без перезапуска.
+// Готовность для /readyz проверяет не Monitor, а Ping.
+func (s *Store) Monitor(ctx context.Context) {
+	ticker := time.NewTicker(s.pingInterval)
+	defer ticker.Stop()
+
+	// New возвращает Store только после успешного подключения
+	up := true
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := ping(ctx, s.Pool)
+		if ctx.Err() != nil {
+			return
+		}
+		switch {
+		case err != nil && up:
+			slog.ErrorContext(ctx, "Database connection lost", "error", err)
+		case err != nil:
+			slog.WarnContext(ctx, "Database is still unavailable", "error", err)
+		case !up:
+			slog.InfoContext(ctx, "Database connection restored")
+		}
+		up = err == nil
+	}
+}
@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListTeams возвращает страницу команд и общее количество команд.
+func (s *Store) ListTeams(ctx context.Context, limit, offset int) ([]models.TeamSummary, int, error) {
+	var total int
+	err := s.Pool.QueryRow(ctx, `
+		SELECT COUNT(DISTINCT team_name)
+		FROM users`).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count teams: %w", err)
+	}
+
+	rows, err := s.Pool.Query(ctx, `
+		SELECT team_name, COUNT(*), COUNT(*) FILTER (WHERE is_active)
+		FROM users
+		GROUP BY team_name
+		ORDER BY team_name
+		LIMIT $1 OFFSET $2`,
+		limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list teams: %w", err)
+	}
+	defer rows.Close()
+
+	teams := []models.TeamSummary{}
+	for rows.Next() {
+		var t models.TeamSummary
+		if err := rows.Scan(&t.TeamName, &t.MembersCount, &t.ActiveCount); err != nil {
+			return nil, 0, fmt.Errorf("scan team: %w", err)
+		}
+		teams = append(teams, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return teams, total, nil
+}
+
+// GetUser возвращает пользователя с количеством открытых ревью.
+func (s *Store) GetUser(ctx context.Context, userID string) (*models.UserDetails, error) {
+	var u models.UserDetails
+	err := s.Pool.QueryRow(ctx, `
+		SELECT u.user_id, u.username, u.team_name, u.is_active, u.role,
+			(SELECT COUNT(*) FROM pull_requests pr
+			 WHERE pr.status = 'OPEN' AND pr.assigned_reviewers ? u.user_id)
+		FROM users u
+		WHERE u.user_id = $1`,
+		userID).Scan(&u.UserID, &u.Username, &u.TeamName, &u.IsActive, &u.Role, &u.OpenReviews)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	return &u, nil
+}
+
+// SearchUsers ищет пользователей по префиксу user_id или username
+// с фильтрами по активности и команде.
+func (s *Store) SearchUsers(ctx context.Context, f models.UserSearchFilter) ([]models.UserDetails, int, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if f.Query != "" {
+		args = append(args, escapeLike(f.Query)+"%")
+		conds = append(conds, fmt.Sprintf("(u.user_id LIKE $%d OR u.username ILIKE $%d)", len(args), len(args)))
+	}
+	if f.TeamName != "" {
+		args = append(args, f.TeamName)
+		conds = append(conds, fmt.Sprintf("u.team_name = $%d", len(args)))
+	}
+	if f.IsActive != nil {
+		args = append(args, *f.IsActive)
+		conds = append(conds, fmt.Sprintf("u.is_active = $%d", len(args)))
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var total int
+	err := s.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM users u `+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count users: %w", err)
+	}
+
+	args = append(args, f.Limit, f.Offset)
+	rows, err := s.Pool.Query(ctx, fmt.Sprintf(`
+		SELECT u.user_id, u.username, u.team_name, u.is_active, u.role,
+			(SELECT COUNT(*) FROM pull_requests pr
+			 WHERE pr.status = 'OPEN' AND pr.assigned_reviewers ? u.user_id)
+		FROM users u
+		%s
+		ORDER BY u.user_id
+		LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search users: %w", err)
+	}
+	defer rows.Close()
+
+	users := []models.UserDetails{}
+	for rows.Next() {
+		var u models.UserDetails
+		if err := rows.Scan(&u.UserID, &u.Username, &u.TeamName, &u.IsActive, &u.Role, &u.OpenReviews); err != nil {
+			return nil, 0, fmt.Errorf("scan user: %w", err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return users, total, nil
+}
+
+// escapeLike экранирует спецсимволы шаблона LIKE.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
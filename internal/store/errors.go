@@ -15,6 +15,10 @@ var (
 	// ErrPRMerged возвращается когда операция недопустима для мерженного PR.
 	ErrPRMerged = errors.New("PR merged")
 
+	// ErrPRNotOpen возвращается когда операция допустима только для открытого PR,
+	// а PR находится в статусе DRAFT или CLOSED.
+	ErrPRNotOpen = errors.New("PR not open")
+
 	// ErrNotAssigned возвращается когда пользователь не назначен ревьювером.
 	ErrNotAssigned = errors.New("reviewer not assigned")
 
@@ -23,4 +27,11 @@ var (
 
 	// ErrNotFound возвращается когда ресурс не найден.
 	ErrNotFound = errors.New("not found")
+
+	// ErrIdentityExists возвращается когда внешняя учётная запись уже привязана к другому пользователю.
+	ErrIdentityExists = errors.New("identity already exists")
+
+	// ErrDeactivationFailed возвращается когда деактивация в режиме all_or_nothing
+	// не удалась хотя бы для одного пользователя и транзакция была откатана.
+	ErrDeactivationFailed = errors.New("deactivation failed")
 )
@@ -0,0 +1,207 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxEventsPage ограничивает число событий, возвращаемых ListEvents за один запрос.
+const maxEventsPage = 1000
+
+// eventsLockKey — ключ advisory lock журнала событий. Двухаргументная форма
+// pg_advisory_xact_lock не пересекается с блокировками команд по hashtext.
+const eventsLockKey = 0x65766e74
+
+// Listener получает события store после успешного коммита транзакции.
+// HandleEvents вызывается синхронно, поэтому реализация не должна блокироваться:
+// долгую работу следует выполнять в собственной горутине.
+type Listener interface {
+	HandleEvents(events []models.Event)
+}
+
+// Subscribe регистрирует слушателя событий.
+func (s *Store) Subscribe(l Listener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, l)
+}
+
+// publish передаёт события всем слушателям.
+func (s *Store) publish(events []models.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	s.mu.RLock()
+	listeners := s.listeners
+	s.mu.RUnlock()
+
+	for _, l := range listeners {
+		l.HandleEvents(events)
+	}
+}
+
+type actorKey struct{}
+
+// WithActor сохраняет в контексте инициатора изменений; он записывается
+// в поле actor всех событий, созданных с этим контекстом.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor возвращает инициатора изменений из контекста или пустую строку.
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// commitWithEvents сохраняет события в журнал в той же транзакции, фиксирует её
+// и передаёт события слушателям. Идентификаторы событий присваиваются журналом,
+// инициатор берётся из контекста.
+//
+// Запись в журнал сериализуется advisory lock до конца транзакции: иначе
+// транзакция с меньшим id могла бы зафиксироваться позже соседней, и клиент,
+// продолжающий поток с Last-Event-ID, пропустил бы её событие.
+func (s *Store) commitWithEvents(ctx context.Context, tx pgx.Tx, events []models.Event) error {
+	if len(events) > 0 {
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1, 0)", eventsLockKey); err != nil {
+			return fmt.Errorf("lock events: %w", err)
+		}
+	}
+
+	actor := Actor(ctx)
+	for i := range events {
+		e := &events[i]
+		e.Actor = actor
+		err := tx.QueryRow(ctx, `
+			INSERT INTO events (type, pull_request_id, user_id, previous_user_id, team_name, reason, actor, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id`,
+			e.Type, e.PullRequestID, e.UserID, e.PreviousUserID, e.TeamName, e.Reason, e.Actor, e.CreatedAt).Scan(&e.ID)
+		if err != nil {
+			return fmt.Errorf("insert event: %w", err)
+		}
+	}
+
+	// Следующая транзакция получит блокировку журнала только после этого коммита,
+	// но опубликовать свои события не раньше, чем их опубликует эта.
+	s.publishMu.Lock()
+	defer s.publishMu.Unlock()
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	s.publish(events)
+	return nil
+}
+
+// ListEvents возвращает события журнала по возрастанию id.
+func (s *Store) ListEvents(ctx context.Context, filter models.EventFilter) ([]models.Event, error) {
+	if filter.Limit <= 0 || filter.Limit > maxEventsPage {
+		filter.Limit = maxEventsPage
+	}
+
+	rows, err := s.Pool.Query(ctx, `
+		SELECT id, type, pull_request_id, user_id, previous_user_id, team_name, reason, actor, created_at
+		FROM events
+		WHERE id > $1
+		AND ($2 = '' OR team_name = $2)
+		AND ($3 = '' OR user_id = $3 OR previous_user_id = $3)
+		ORDER BY id
+		LIMIT $4`,
+		filter.AfterID, filter.TeamName, filter.UserID, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.Event
+	for rows.Next() {
+		var e models.Event
+		if err := rows.Scan(&e.ID, &e.Type, &e.PullRequestID, &e.UserID, &e.PreviousUserID,
+			&e.TeamName, &e.Reason, &e.Actor, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan event: %w", err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+	return events, nil
+}
+
+// PruneEvents удаляет из журнала события старше указанного момента.
+func (s *Store) PruneEvents(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := s.Pool.Exec(ctx, `DELETE FROM events WHERE created_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("prune events: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// prEvent формирует событие жизненного цикла PR; пользователем события считается автор.
+func prEvent(eventType string, pr *models.PullRequest, teamName string) models.Event {
+	return models.Event{
+		Type:          eventType,
+		PullRequestID: pr.PullRequestID,
+		UserID:        pr.AuthorID,
+		TeamName:      teamName,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// userEvent формирует событие активации или деактивации пользователя.
+func userEvent(user *models.User, reason string) models.Event {
+	eventType := models.EventUserDeactivated
+	if user.IsActive {
+		eventType = models.EventUserActivated
+	}
+	return models.Event{
+		Type:      eventType,
+		UserID:    user.UserID,
+		TeamName:  user.TeamName,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+}
+
+// assignedEvents формирует события назначения для всех ревьюеров PR.
+func assignedEvents(pr *models.PullRequest, teamName string) []models.Event {
+	now := time.Now()
+	events := make([]models.Event, 0, len(pr.AssignedReviewers))
+	for _, id := range pr.AssignedReviewers {
+		events = append(events, models.Event{
+			Type:          models.EventReviewerAssigned,
+			PullRequestID: pr.PullRequestID,
+			UserID:        id,
+			TeamName:      teamName,
+			CreatedAt:     now,
+		})
+	}
+	return events
+}
+
+// replacedEvents формирует события замены; для PR без кандидата — событие снятия ревьюера.
+func replacedEvents(moves []models.Reassignment, teamName, reason string) []models.Event {
+	now := time.Now()
+	events := make([]models.Event, 0, len(moves))
+	for _, m := range moves {
+		eventType := models.EventReviewerReplaced
+		if m.NewReviewerID == "" {
+			eventType = models.EventReviewerRemoved
+		}
+		events = append(events, models.Event{
+			Type:           eventType,
+			PullRequestID:  m.PullRequestID,
+			UserID:         m.NewReviewerID,
+			PreviousUserID: m.OldReviewerID,
+			TeamName:       teamName,
+			Reason:         reason,
+			CreatedAt:      now,
+		})
+	}
+	return events
+}
@@ -0,0 +1,340 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CreateLinkedPR создает PR, связанный с pull/merge request во внешней системе.
+// Черновик создаётся в статусе DRAFT без ревьюеров.
+func (s *Store) CreateLinkedPR(ctx context.Context, prID, prName, authorID string, draft bool, ref models.ExternalRef) (*models.PullRequest, error) {
+	ctx, span := startOp(ctx, "store.CreateLinkedPR", attribute.String("pr_id", prID))
+	defer span.End()
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	pr, teamName, err := s.createPRInTx(ctx, tx, prID, prName, authorID, draft)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO pr_external_refs (pull_request_id, provider, repository, number, url)
+		VALUES ($1, $2, $3, $4, $5)`,
+		prID, ref.Provider, ref.Repository, ref.Number, ref.URL)
+	if err != nil {
+		return nil, fmt.Errorf("insert external ref: %w", err)
+	}
+
+	events := append([]models.Event{prEvent(models.EventPRCreated, pr, teamName)}, assignedEvents(pr, teamName)...)
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// GetExternalRef возвращает ссылку PR на внешнюю систему.
+func (s *Store) GetExternalRef(ctx context.Context, prID string) (*models.ExternalRef, error) {
+	var ref models.ExternalRef
+	err := s.Pool.QueryRow(ctx, `
+		SELECT provider, repository, number, url
+		FROM pr_external_refs
+		WHERE pull_request_id = $1`,
+		prID).Scan(&ref.Provider, &ref.Repository, &ref.Number, &ref.URL)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get external ref: %w", err)
+	}
+	return &ref, nil
+}
+
+// MarkReadyForReview переводит черновик в статус OPEN и назначает ревьюеров.
+// Для уже открытого PR операция идемпотентна; закрытый PR открывается только через ReopenPR.
+func (s *Store) MarkReadyForReview(ctx context.Context, prID string) (*models.PullRequest, error) {
+	ctx, span := startOp(ctx, "store.MarkReadyForReview", attribute.String("pr_id", prID))
+	defer span.End()
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var authorID string
+	err = tx.QueryRow(ctx, `
+		SELECT author_id FROM pull_requests WHERE pull_request_id = $1`,
+		prID).Scan(&authorID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get PR author: %w", err)
+	}
+
+	// Блокируем команду автора для предотвращения гонок данных
+	authorTeam, err := s.lockTeamByUserID(ctx, tx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := getPRForUpdateInTx(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pr.Status {
+	case "MERGED":
+		return nil, ErrPRMerged
+	case "CLOSED":
+		return nil, ErrPRNotOpen
+	case "OPEN":
+		return pr, nil
+	}
+
+	pr.AssignedReviewers, pr.RuleViolations, err = s.pickReviewersInTx(ctx, tx, authorTeam, prID, authorID)
+	if err != nil {
+		return nil, err
+	}
+	pr.Status = "OPEN"
+
+	_, err = tx.Exec(ctx, `
+		UPDATE pull_requests
+		SET status = 'OPEN', assigned_reviewers = $1
+		WHERE pull_request_id = $2`,
+		pr.AssignedReviewers, prID)
+	if err != nil {
+		return nil, fmt.Errorf("mark ready for review: %w", err)
+	}
+
+	if err := s.commitWithEvents(ctx, tx, assignedEvents(pr, authorTeam)); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// ClosePR закрывает PR без мержа. Для уже закрытого PR операция идемпотентна.
+// Ревьюеры остаются назначенными, чтобы ReopenPR мог вернуть активных из них.
+func (s *Store) ClosePR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	ctx, span := startOp(ctx, "store.ClosePR", attribute.String("pr_id", prID))
+	defer span.End()
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	pr, err := getPRForUpdateInTx(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pr.Status {
+	case "MERGED":
+		return nil, ErrPRMerged
+	case "CLOSED":
+		return pr, nil
+	}
+
+	var teamName string
+	err = tx.QueryRow(ctx, `
+		UPDATE pull_requests p SET status = 'CLOSED'
+		WHERE p.pull_request_id = $1
+		RETURNING COALESCE((SELECT team_name FROM users WHERE user_id = p.author_id), '')`,
+		prID).Scan(&teamName)
+	if err != nil {
+		return nil, fmt.Errorf("close PR: %w", err)
+	}
+	pr.Status = "CLOSED"
+
+	if err := s.commitWithEvents(ctx, tx, []models.Event{prEvent(models.EventPRClosed, pr, teamName)}); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// ReopenPR повторно открывает закрытый PR. Активные ревьюеры сохраняются;
+// если активных не осталось, ревьюеры назначаются заново.
+func (s *Store) ReopenPR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	ctx, span := startOp(ctx, "store.ReopenPR", attribute.String("pr_id", prID))
+	defer span.End()
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var authorID string
+	err = tx.QueryRow(ctx, `
+		SELECT author_id FROM pull_requests WHERE pull_request_id = $1`,
+		prID).Scan(&authorID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get PR author: %w", err)
+	}
+
+	// Блокируем команду автора для предотвращения гонок данных
+	authorTeam, err := s.lockTeamByUserID(ctx, tx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := getPRForUpdateInTx(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pr.Status {
+	case "MERGED":
+		return nil, ErrPRMerged
+	case "OPEN", "DRAFT":
+		return pr, nil
+	}
+
+	var active []string
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(jsonb_agg(r.reviewer), '[]'::jsonb)
+		FROM jsonb_array_elements_text($1::jsonb) AS r(reviewer)
+		JOIN users u ON u.user_id = r.reviewer AND u.is_active = true`,
+		pr.AssignedReviewers).Scan(&active)
+	if err != nil {
+		return nil, fmt.Errorf("get active reviewers: %w", err)
+	}
+
+	pr.AssignedReviewers = active
+	repicked := len(active) == 0
+	if repicked {
+		pr.AssignedReviewers, pr.RuleViolations, err = s.pickReviewersInTx(ctx, tx, authorTeam, prID, authorID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pr.Status = "OPEN"
+
+	_, err = tx.Exec(ctx, `
+		UPDATE pull_requests
+		SET status = 'OPEN', assigned_reviewers = $1
+		WHERE pull_request_id = $2`,
+		pr.AssignedReviewers, prID)
+	if err != nil {
+		return nil, fmt.Errorf("reopen PR: %w", err)
+	}
+
+	var events []models.Event
+	if repicked {
+		events = assignedEvents(pr, authorTeam)
+	}
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// ResolveUser находит внутренний user_id по идентификатору во внешней системе
+// через привязку в user_identities. Только при включённом LoginFallback логин
+// (если он известен) без привязки сопоставляется с user_id и с username без
+// учёта регистра.
+func (s *Store) ResolveUser(ctx context.Context, provider, externalID, login string) (string, error) {
+	identity, err := s.LookupIdentity(ctx, provider, externalID)
+	if err == nil {
+		return identity.UserID, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return "", fmt.Errorf("resolve user: %w", err)
+	}
+	if !s.loginFallback || login == "" {
+		return "", fmt.Errorf("resolve %s user %s: %w", provider, externalID, ErrNotFound)
+	}
+
+	var userID string
+	err = s.Pool.QueryRow(ctx, `
+		SELECT user_id FROM users
+		WHERE user_id = $1 OR lower(username) = lower($1)
+		ORDER BY (user_id = $1) DESC, user_id
+		LIMIT 1`,
+		login).Scan(&userID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", fmt.Errorf("resolve %s user %s: %w", provider, login, ErrNotFound)
+		}
+		return "", fmt.Errorf("resolve user: %w", err)
+	}
+	return userID, nil
+}
+
+func getPRForUpdateInTx(ctx context.Context, tx pgx.Tx, prID string) (*models.PullRequest, error) {
+	var pr models.PullRequest
+	var mergedAt *time.Time
+	err := tx.QueryRow(ctx, `
+		SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at
+		FROM pull_requests WHERE pull_request_id = $1 FOR UPDATE`,
+		prID).Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status,
+		&pr.AssignedReviewers, &pr.CreatedAt, &mergedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get PR: %w", err)
+	}
+	pr.MergedAt = mergedAt
+	return &pr, nil
+}
+
+// ExternalLogin возвращает логин пользователя у провайдера из привязки в
+// user_identities. Только при включённом LoginFallback логином пользователя
+// без привязки считается username; иначе — ErrNotFound, как и в ResolveUser.
+func (s *Store) ExternalLogin(ctx context.Context, userID, provider string) (string, error) {
+	var externalID *string
+	var username string
+	err := s.Pool.QueryRow(ctx, `
+		SELECT i.external_id, u.username
+		FROM users u
+		LEFT JOIN user_identities i ON i.user_id = u.user_id AND i.provider = $2
+		WHERE u.user_id = $1`,
+		userID, provider).Scan(&externalID, &username)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", fmt.Errorf("%s login for %s: %w", provider, userID, ErrNotFound)
+		}
+		return "", fmt.Errorf("get external login: %w", err)
+	}
+	switch {
+	case externalID != nil:
+		return *externalID, nil
+	case s.loginFallback:
+		return username, nil
+	}
+	return "", fmt.Errorf("%s login for %s: no identity linked: %w", provider, userID, ErrNotFound)
+}
@@ -0,0 +1,180 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Коды ошибок PostgreSQL, которые обрабатываются отдельно.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+// SetIdentity привязывает внешнюю учётную запись к пользователю.
+// У пользователя может быть одна запись на провайдера; существующая заменяется.
+func (s *Store) SetIdentity(ctx context.Context, identity models.Identity) error {
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	if err := setIdentityInTx(ctx, tx, identity); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// setIdentityInTx сохраняет привязку внутри транзакции.
+func setIdentityInTx(ctx context.Context, tx pgx.Tx, identity models.Identity) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO user_identities (user_id, provider, external_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, provider) DO UPDATE SET external_id = EXCLUDED.external_id`,
+		identity.UserID, identity.Provider, identity.ExternalID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgUniqueViolation:
+				return fmt.Errorf("%s identity %s: %w", identity.Provider, identity.ExternalID, ErrIdentityExists)
+			case pgForeignKeyViolation:
+				return fmt.Errorf("user %s: %w", identity.UserID, ErrNotFound)
+			}
+		}
+		return fmt.Errorf("set identity: %w", err)
+	}
+	return nil
+}
+
+// ListIdentities возвращает внешние учётные записи пользователя.
+func (s *Store) ListIdentities(ctx context.Context, userID string) ([]models.Identity, error) {
+	var exists bool
+	err := s.Pool.QueryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)`,
+		userID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("check user exists: %w", err)
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	identities, err := getIdentities(ctx, s.Pool, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	return identities[userID], nil
+}
+
+// DeleteIdentity удаляет привязку пользователя к провайдеру.
+func (s *Store) DeleteIdentity(ctx context.Context, userID, provider string) error {
+	tag, err := s.Pool.Exec(ctx, `
+		DELETE FROM user_identities WHERE user_id = $1 AND provider = $2`,
+		userID, provider)
+	if err != nil {
+		return fmt.Errorf("delete identity: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// LookupIdentity находит пользователя по внешней учётной записи.
+// Сравнение external_id регистронезависимое.
+func (s *Store) LookupIdentity(ctx context.Context, provider, externalID string) (*models.Identity, error) {
+	identity := models.Identity{Provider: provider}
+	err := s.Pool.QueryRow(ctx, `
+		SELECT user_id, external_id FROM user_identities
+		WHERE provider = $1 AND lower(external_id) = lower($2)`,
+		provider, externalID).Scan(&identity.UserID, &identity.ExternalID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("lookup identity: %w", err)
+	}
+	return &identity, nil
+}
+
+// getIdentities возвращает учётные записи указанных пользователей, сгруппированные по user_id.
+func getIdentities(ctx context.Context, q querier, userIDs []string) (map[string][]models.Identity, error) {
+	rows, err := q.Query(ctx, `
+		SELECT user_id, provider, external_id FROM user_identities
+		WHERE user_id = ANY($1)
+		ORDER BY user_id, provider`,
+		userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get identities: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]models.Identity)
+	for rows.Next() {
+		var i models.Identity
+		if err := rows.Scan(&i.UserID, &i.Provider, &i.ExternalID); err != nil {
+			return nil, fmt.Errorf("scan identity: %w", err)
+		}
+		result[i.UserID] = append(result[i.UserID], i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+	return result, nil
+}
+
+// GetIdentity возвращает учётную запись пользователя у провайдера.
+func (s *Store) GetIdentity(ctx context.Context, userID, provider string) (*models.Identity, error) {
+	identity := models.Identity{UserID: userID, Provider: provider}
+	err := s.Pool.QueryRow(ctx, `
+		SELECT external_id FROM user_identities
+		WHERE user_id = $1 AND provider = $2`,
+		userID, provider).Scan(&identity.ExternalID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get identity: %w", err)
+	}
+	return &identity, nil
+}
+
+// ListProviderIdentities возвращает все привязки провайдера у активных пользователей.
+func (s *Store) ListProviderIdentities(ctx context.Context, provider string) ([]models.Identity, error) {
+	rows, err := s.Pool.Query(ctx, `
+		SELECT i.user_id, i.provider, i.external_id
+		FROM user_identities i
+		JOIN users u ON u.user_id = i.user_id
+		WHERE i.provider = $1 AND u.is_active = true
+		ORDER BY i.user_id`,
+		provider)
+	if err != nil {
+		return nil, fmt.Errorf("list provider identities: %w", err)
+	}
+	defer rows.Close()
+
+	var identities []models.Identity
+	for rows.Next() {
+		var i models.Identity
+		if err := rows.Scan(&i.UserID, &i.Provider, &i.ExternalID); err != nil {
+			return nil, fmt.Errorf("scan identity: %w", err)
+		}
+		identities = append(identities, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+	return identities, nil
+}
@@ -2,30 +2,25 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/2Empty/review-assigner/internal/models"
 	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func deactivateUserInTx(ctx context.Context, tx pgx.Tx, userID string) (*models.User, error) {
+// deactivateUserInTx деактивирует пользователя и переназначает его открытые ревью.
+// Возвращает список замен; для PR без кандидата NewReviewerID остаётся пустым.
+func deactivateUserInTx(ctx context.Context, tx pgx.Tx, userID string) (*models.User, []models.Reassignment, error) {
+	ctx, span := startOp(ctx, "store.deactivateUser", attribute.String("user_id", userID))
+	defer span.End()
+
 	// Пытаемся переназначить ревьюеров для открытых PR
-	prs, err := getUserReviewsInTx(ctx, tx, userID)
+	reassignments, err := releaseReviewsInTx(ctx, tx, userID)
 	if err != nil {
-		// Если не удалось получить список, всё равно деактивируем пользователя
-		log.Printf("Failed to get user reviews for reassignment: %v", err)
-	} else {
-		for _, pr := range prs {
-			if pr.Status == "OPEN" {
-				_, _, err := reassignReviewerInTx(ctx, tx, pr.PullRequestID, userID)
-				if err != nil {
-					// Логируем, но продолжаем - не критично если не удалось переназначить
-					log.Printf("Failed to reassign reviewer for PR %s: %v", pr.PullRequestID, err)
-				}
-			}
-		}
+		return nil, nil, err
 	}
 
 	// Деактивируем пользователя
@@ -34,16 +29,74 @@ func deactivateUserInTx(ctx context.Context, tx pgx.Tx, userID string) (*models.
 		UPDATE users
 		SET is_active = false
 		WHERE user_id = $1
-		RETURNING user_id, username, team_name, is_active`,
-		userID).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive)
+		RETURNING user_id, username, team_name, is_active, role`,
+		userID).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Role)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("set user active: %w", ErrNotFound)
+			return nil, nil, fmt.Errorf("set user active: %w", ErrNotFound)
+		}
+		return nil, nil, fmt.Errorf("set user active: %w", err)
+	}
+	return &user, reassignments, nil
+}
+
+// releaseReviewsInTx передаёт открытые ревью пользователя другим участникам его текущей команды.
+// С PR без кандидата пользователь снимается без замены, NewReviewerID остаётся пустым.
+// Прочие ошибки возвращаются, чтобы вызывающий мог откатить точку сохранения
+// и отразить ошибку в отчёте.
+func releaseReviewsInTx(ctx context.Context, tx pgx.Tx, userID string) ([]models.Reassignment, error) {
+	prs, err := getUserReviewsInTx(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reassignments := []models.Reassignment{}
+	for _, pr := range prs {
+		if pr.Status != "OPEN" {
+			continue
+		}
+		_, newUserID, err := reassignReviewerInTx(ctx, tx, pr.PullRequestID, userID)
+		if errors.Is(err, ErrNoCandidate) {
+			err = removeReviewerInTx(ctx, tx, pr.PullRequestID, userID)
 		}
-		return nil, fmt.Errorf("set user active: %w", err)
+		if err != nil {
+			return nil, &reassignError{PullRequestID: pr.PullRequestID, Err: err}
+		}
+		reassignments = append(reassignments, models.Reassignment{
+			PullRequestID: pr.PullRequestID,
+			OldReviewerID: userID,
+			NewReviewerID: newUserID,
+		})
+	}
+	return reassignments, nil
+}
+
+// removeReviewerInTx снимает ревьюера с PR без замены.
+func removeReviewerInTx(ctx context.Context, tx pgx.Tx, prID, userID string) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE pull_requests
+		SET assigned_reviewers = assigned_reviewers - $2::text
+		WHERE pull_request_id = $1`,
+		prID, userID)
+	if err != nil {
+		return fmt.Errorf("remove reviewer: %w", err)
 	}
-	return &user, nil
+	return nil
+}
+
+// reassignError — ошибка переназначения ревьюера на конкретном PR.
+type reassignError struct {
+	PullRequestID string
+	Err           error
+}
+
+func (e *reassignError) Error() string {
+	return fmt.Sprintf("reassign %s: %v", e.PullRequestID, e.Err)
+}
+
+func (e *reassignError) Unwrap() error {
+	return e.Err
 }
 
 func getUserReviewsInTx(ctx context.Context, tx pgx.Tx, userID string) ([]models.PullRequest, error) {
@@ -92,8 +145,12 @@ func reassignReviewerInTx(ctx context.Context, tx pgx.Tx, prID, oldUserID string
 
 	pr.MergedAt = mergedAt
 
-	if pr.Status == "MERGED" {
+	switch pr.Status {
+	case "OPEN":
+	case "MERGED":
 		return nil, "", ErrPRMerged
+	default:
+		return nil, "", ErrPRNotOpen
 	}
 
 	if !contains(pr.AssignedReviewers, oldUserID) {
@@ -101,7 +158,7 @@ func reassignReviewerInTx(ctx context.Context, tx pgx.Tx, prID, oldUserID string
 	}
 
 	rows, err := tx.Query(ctx, `
-    SELECT u.user_id FROM users u
+    SELECT u.user_id, u.role FROM users u
     WHERE u.team_name = (SELECT team_name FROM users WHERE user_id = $1)
     AND u.is_active = true
     AND u.user_id != $1
@@ -115,26 +172,41 @@ func reassignReviewerInTx(ctx context.Context, tx pgx.Tx, prID, oldUserID string
 	if err != nil {
 		return nil, "", fmt.Errorf("get candidates: %w", err)
 	}
-	defer rows.Close()
+	candidates, err := scanCandidates(rows)
+	if err != nil {
+		return nil, "", err
+	}
 
-	var candidates []string
-	for rows.Next() {
-		var userID string
-		if err := rows.Scan(&userID); err != nil {
-			return nil, "", fmt.Errorf("scan candidate: %w", err)
-		}
-		candidates = append(candidates, userID)
+	if len(candidates) == 0 {
+		return nil, "", ErrNoCandidate
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, "", fmt.Errorf("rows iteration: %w", err)
+	var teamName string
+	err = tx.QueryRow(ctx, `SELECT team_name FROM users WHERE user_id = $1`, oldUserID).Scan(&teamName)
+	if err != nil {
+		return nil, "", fmt.Errorf("get reviewer team: %w", err)
+	}
+	rules, err := getTeamRules(ctx, tx, teamName)
+	if err != nil {
+		return nil, "", err
 	}
 
-	if len(candidates) == 0 {
-		return nil, "", ErrNoCandidate
+	var remaining []candidate
+	if len(rules) > 0 {
+		roles, err := getUserRolesInTx(ctx, tx, pr.AssignedReviewers)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, id := range pr.AssignedReviewers {
+			if id != oldUserID {
+				remaining = append(remaining, candidate{UserID: id, Role: roles[id]})
+			}
+		}
 	}
 
-	newUserID := candidates[0]
+	replacement, violations := pickReplacement(candidates, remaining, rules)
+	newUserID := replacement.UserID
+	pr.RuleViolations = violations
 
 	newReviewers := replaceInSlice(pr.AssignedReviewers, oldUserID, newUserID)
 
@@ -0,0 +1,256 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// defaultReviewersPerPR — количество ревьюеров на PR, если оно не задано в Options.
+const defaultReviewersPerPR = 2
+
+// candidate представляет кандидата в ревьюеры вместе с его ролью.
+type candidate struct {
+	UserID string
+	Role   string
+}
+
+// roleRank возвращает старшинство роли: чем больше, тем старше.
+func roleRank(role string) int {
+	switch role {
+	case models.RoleLead:
+		return 3
+	case models.RoleSenior:
+		return 2
+	case models.RoleJunior:
+		return 1
+	}
+	return 0
+}
+
+// SetTeamRules заменяет правила назначения ревьюеров для команды.
+func (s *Store) SetTeamRules(ctx context.Context, teamName string, rules []models.ReviewRule) error {
+	ctx, span := startOp(ctx, "store.SetTeamRules", attribute.String("team", teamName))
+	defer span.End()
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	if err := s.lockTeamByName(ctx, tx, teamName); err != nil {
+		return err
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM users WHERE team_name = $1)`,
+		teamName).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("check team existence: %w", err)
+	}
+	if !exists {
+		return ErrTeamNotFound
+	}
+
+	if err := replaceTeamRulesInTx(ctx, tx, teamName, rules); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func replaceTeamRulesInTx(ctx context.Context, tx pgx.Tx, teamName string, rules []models.ReviewRule) error {
+	_, err := tx.Exec(ctx, `DELETE FROM team_review_rules WHERE team_name = $1`, teamName)
+	if err != nil {
+		return fmt.Errorf("delete team rules: %w", err)
+	}
+
+	for _, r := range rules {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO team_review_rules (team_name, role, min_count)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (team_name, role) DO UPDATE SET min_count = EXCLUDED.min_count`,
+			teamName, r.Role, r.MinCount)
+		if err != nil {
+			return fmt.Errorf("insert team rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// getTeamRules возвращает правила команды, отсортированные по убыванию старшинства роли.
+func getTeamRules(ctx context.Context, q querier, teamName string) ([]models.ReviewRule, error) {
+	rows, err := q.Query(ctx, `
+		SELECT role, min_count
+		FROM team_review_rules
+		WHERE team_name = $1`,
+		teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get team rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []models.ReviewRule
+	for rows.Next() {
+		var r models.ReviewRule
+		if err := rows.Scan(&r.Role, &r.MinCount); err != nil {
+			return nil, fmt.Errorf("scan team rule: %w", err)
+		}
+		rules = append(rules, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	// Более старшие роли обрабатываются первыми: они же закрывают младшие правила.
+	sort.Slice(rules, func(i, j int) bool {
+		return roleRank(rules[i].Role) > roleRank(rules[j].Role)
+	})
+	return rules, nil
+}
+
+// getUserRolesInTx возвращает роли указанных пользователей.
+func getUserRolesInTx(ctx context.Context, tx pgx.Tx, userIDs []string) (map[string]string, error) {
+	roles := make(map[string]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return roles, nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT user_id, role FROM users WHERE user_id = ANY($1)`,
+		userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get user roles: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, role string
+		if err := rows.Scan(&id, &role); err != nil {
+			return nil, fmt.Errorf("scan user role: %w", err)
+		}
+		roles[id] = role
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return roles, nil
+}
+
+// selectReviewers выбирает до maxReviewers ревьюеров.
+// Сначала выполняются правила ролей команды, оставшиеся места заполняются случайно.
+func selectReviewers(candidates []candidate, rules []models.ReviewRule, maxReviewers int) ([]string, []models.RuleViolation) {
+	shuffled := make([]candidate, len(candidates))
+	copy(shuffled, candidates)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	var chosen []candidate
+	taken := make(map[string]bool)
+
+	for _, rule := range rules {
+		for _, c := range shuffled {
+			if len(chosen) >= maxReviewers || countRole(chosen, rule.Role) >= rule.MinCount {
+				break
+			}
+			if !taken[c.UserID] && roleRank(c.Role) >= roleRank(rule.Role) {
+				chosen = append(chosen, c)
+				taken[c.UserID] = true
+			}
+		}
+	}
+
+	for _, c := range shuffled {
+		if len(chosen) >= maxReviewers {
+			break
+		}
+		if !taken[c.UserID] {
+			chosen = append(chosen, c)
+			taken[c.UserID] = true
+		}
+	}
+
+	reviewers := make([]string, 0, len(chosen))
+	for _, c := range chosen {
+		reviewers = append(reviewers, c.UserID)
+	}
+	return reviewers, checkRules(chosen, rules)
+}
+
+// pickReplacement выбирает замену ревьюеру так, чтобы вместе с оставшимися
+// ревьюерами нарушалось как можно меньше правил. При равенстве берётся первый кандидат.
+func pickReplacement(candidates, remaining []candidate, rules []models.ReviewRule) (candidate, []models.RuleViolation) {
+	var (
+		best          candidate
+		bestViolation []models.RuleViolation
+	)
+	for i, c := range candidates {
+		v := checkRules(append(remaining[:len(remaining):len(remaining)], c), rules)
+		if i == 0 || len(v) < len(bestViolation) {
+			best, bestViolation = c, v
+		}
+		if len(v) == 0 {
+			break
+		}
+	}
+	return best, bestViolation
+}
+
+// checkRules возвращает правила, не выполненные набором ревьюеров.
+func checkRules(reviewers []candidate, rules []models.ReviewRule) []models.RuleViolation {
+	var violations []models.RuleViolation
+	for _, rule := range rules {
+		if n := countRole(reviewers, rule.Role); n < rule.MinCount {
+			violations = append(violations, models.RuleViolation{
+				Role:     rule.Role,
+				Required: rule.MinCount,
+				Assigned: n,
+			})
+		}
+	}
+	return violations
+}
+
+// countRole считает ревьюеров с ролью не ниже указанной.
+func countRole(reviewers []candidate, role string) int {
+	n := 0
+	for _, c := range reviewers {
+		if roleRank(c.Role) >= roleRank(role) {
+			n++
+		}
+	}
+	return n
+}
+
+// scanCandidates читает строки (user_id, role) в список кандидатов.
+func scanCandidates(rows pgx.Rows) ([]candidate, error) {
+	defer rows.Close()
+
+	var candidates []candidate
+	for rows.Next() {
+		var c candidate
+		if err := rows.Scan(&c.UserID, &c.Role); err != nil {
+			return nil, fmt.Errorf("scan candidate: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+	return candidates, nil
+}
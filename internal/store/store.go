@@ -5,44 +5,151 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
-	"math/rand/v2"
-	"os"
-	"strings"
+	"log/slog"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/2Empty/review-assigner/internal/logging"
 	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/2Empty/review-assigner/internal/tracing"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Store предоставляет методы для работы с данными.
 type Store struct {
 	Pool *pgxpool.Pool
+
+	reviewersPerPR     int
+	deactivationPolicy string
+	loginFallback      bool
+
+	pingInterval time.Duration
+
+	mu        sync.RWMutex
+	listeners []Listener
+
+	// publishMu упорядочивает фиксацию и публикацию событий внутри процесса.
+	publishMu sync.Mutex
+}
+
+// Options задаёт подключение к базе, параметры пула и правила назначения по умолчанию.
+// Нулевые значения параметров пула оставляют значения pgxpool.
+type Options struct {
+	URL               string
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+
+	// ConnectTimeout ограничивает ожидание базы при старте; попытки повторяются
+	// с задержкой от ConnectMinBackoff, удваивающейся до ConnectMaxBackoff.
+	ConnectTimeout    time.Duration
+	ConnectMinBackoff time.Duration
+	ConnectMaxBackoff time.Duration
+	// PingInterval — период проверки базы в Monitor.
+	PingInterval time.Duration
+
+	// ReviewersPerPR — количество ревьюеров на PR, по умолчанию 2.
+	ReviewersPerPR int
+	// DeactivationPolicy — политика DeactivateTeamUsers, если она не передана явно.
+	DeactivationPolicy string
+	// LoginFallback разрешает ResolveUser сопоставлять логин без привязки
+	// с user_id или username, а ExternalLogin — возвращать username вместо логина.
+	LoginFallback bool
+}
+
+// startOp начинает спан операции store и добавляет те же атрибуты к контексту журнала,
+// чтобы записи внутри операции содержали идентификаторы PR, пользователя и команды.
+func startOp(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	args := make([]any, 0, len(attrs))
+	for _, a := range attrs {
+		args = append(args, slog.Any(string(a.Key), a.Value.AsInterface()))
+	}
+	return tracing.Start(logging.With(ctx, args...), name, attrs...)
+}
+
+// querier — общий интерфейс пула и транзакции для выполнения запросов.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
-// New создает новый экземпляр Store.
-func New(ctx context.Context) (*Store, error) {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		return nil, fmt.Errorf("DATABASE_URL is not set")
+// New создает новый экземпляр Store и дожидается доступности базы.
+func New(ctx context.Context, opts Options) (*Store, error) {
+	if opts.URL == "" {
+		return nil, fmt.Errorf("database URL is not set")
 	}
 
-	config, err := pgxpool.ParseConfig(dbURL)
+	config, err := pgxpool.ParseConfig(opts.URL)
 	if err != nil {
 		return nil, fmt.Errorf("parse db config: %w", err)
 	}
+	if opts.MaxConns > 0 {
+		config.MaxConns = opts.MaxConns
+	}
+	if opts.MinConns > 0 {
+		config.MinConns = opts.MinConns
+	}
+	if opts.MaxConnLifetime > 0 {
+		config.MaxConnLifetime = opts.MaxConnLifetime
+	}
+	if opts.MaxConnIdleTime > 0 {
+		config.MaxConnIdleTime = opts.MaxConnIdleTime
+	}
+	if opts.HealthCheckPeriod > 0 {
+		config.HealthCheckPeriod = opts.HealthCheckPeriod
+	}
+	// Спан на каждый запрос; без настроенного экспортера спаны не записываются
+	config.ConnConfig.Tracer = tracing.QueryTracer{}
+
+	if opts.ConnectTimeout <= 0 {
+		opts.ConnectTimeout = defaultConnectTimeout
+	}
+	if opts.ConnectMinBackoff <= 0 {
+		opts.ConnectMinBackoff = defaultConnectMinBackoff
+	}
+	if opts.ConnectMaxBackoff < opts.ConnectMinBackoff {
+		opts.ConnectMaxBackoff = max(defaultConnectMaxBackoff, opts.ConnectMinBackoff)
+	}
+	if opts.PingInterval <= 0 {
+		opts.PingInterval = defaultPingInterval
+	}
 
+	// Пул создаётся без установки соединений, поэтому ошибки подключения проявятся в connect
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("connect to db: %w", err)
+		return nil, fmt.Errorf("create pool: %w", err)
+	}
+	if err := connect(ctx, pool, opts.ConnectTimeout, opts.ConnectMinBackoff, opts.ConnectMaxBackoff); err != nil {
+		pool.Close()
+		return nil, err
 	}
 
-	if err := pool.Ping(ctx); err != nil {
-		return nil, fmt.Errorf("ping db: %w", err)
+	if opts.ReviewersPerPR <= 0 {
+		opts.ReviewersPerPR = defaultReviewersPerPR
+	}
+	if opts.DeactivationPolicy == "" {
+		opts.DeactivationPolicy = models.PolicyAllOrNothing
 	}
 
-	return &Store{Pool: pool}, nil
+	s := &Store{
+		Pool:               pool,
+		reviewersPerPR:     opts.ReviewersPerPR,
+		loginFallback:      opts.LoginFallback,
+		deactivationPolicy: opts.DeactivationPolicy,
+		pingInterval:       opts.PingInterval,
+	}
+	return s, nil
+}
+
+// ReviewersPerPR возвращает количество ревьюеров, назначаемых на PR.
+func (s *Store) ReviewersPerPR() int {
+	return s.reviewersPerPR
 }
 
 // Close закрывает соединение с базой данных
@@ -54,13 +161,16 @@ func (s *Store) Close() {
 
 // CreateTeam создает новую команду в базе данных.
 func (s *Store) CreateTeam(ctx context.Context, t models.Team) error {
+	ctx, span := startOp(ctx, "store.CreateTeam", attribute.String("team", t.TeamName))
+	defer span.End()
+
 	tx, err := s.Pool.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
 	}
 	defer func() {
 		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
-			log.Printf("Failed to rollback transaction: %v", err)
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
 		}
 	}()
 
@@ -83,17 +193,33 @@ func (s *Store) CreateTeam(ctx context.Context, t models.Team) error {
 	}
 
 	for _, m := range t.Members {
+		role := m.Role
+		if role == "" {
+			role = models.RoleJunior
+		}
 		_, err = tx.Exec(ctx, `
-            INSERT INTO users (user_id, username, team_name, is_active)
-            VALUES ($1, $2, $3, $4)
+            INSERT INTO users (user_id, username, team_name, is_active, role)
+            VALUES ($1, $2, $3, $4, $5)
             ON CONFLICT (user_id) DO UPDATE SET
                 username = EXCLUDED.username,
                 team_name = EXCLUDED.team_name,
-                is_active = EXCLUDED.is_active`,
-			m.UserID, m.Username, t.TeamName, m.IsActive)
+                is_active = EXCLUDED.is_active,
+                role = EXCLUDED.role`,
+			m.UserID, m.Username, t.TeamName, m.IsActive, role)
 		if err != nil {
 			return fmt.Errorf("insert/update user: %w", err)
 		}
+
+		for _, identity := range m.Identities {
+			identity.UserID = m.UserID
+			if err := setIdentityInTx(ctx, tx, identity); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := replaceTeamRulesInTx(ctx, tx, t.TeamName, t.ReviewRules); err != nil {
+		return err
 	}
 
 	return tx.Commit(ctx)
@@ -103,7 +229,7 @@ func (s *Store) CreateTeam(ctx context.Context, t models.Team) error {
 func (s *Store) GetTeam(ctx context.Context, teamName string) (*models.Team, error) {
 	team := &models.Team{TeamName: teamName}
 	query := `
-	SELECT user_id, username, is_active
+	SELECT user_id, username, is_active, role
 	FROM users
 	WHERE team_name = $1`
 	rows, err := s.Pool.Query(ctx, query, teamName)
@@ -115,7 +241,7 @@ func (s *Store) GetTeam(ctx context.Context, teamName string) (*models.Team, err
 	members := []models.TeamMember{}
 	for rows.Next() {
 		var m models.TeamMember
-		if err := rows.Scan(&m.UserID, &m.Username, &m.IsActive); err != nil {
+		if err := rows.Scan(&m.UserID, &m.Username, &m.IsActive, &m.Role); err != nil {
 			return nil, fmt.Errorf("rows scan in getteam: %w", err)
 		}
 		members = append(members, m)
@@ -127,8 +253,28 @@ func (s *Store) GetTeam(ctx context.Context, teamName string) (*models.Team, err
 	if len(members) == 0 {
 		return nil, fmt.Errorf("GetTeam: %w", ErrTeamNotFound)
 	}
+
+	ids := make([]string, len(members))
+	for i, m := range members {
+		ids[i] = m.UserID
+	}
+	identities, err := getIdentities(ctx, s.Pool, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		for _, identity := range identities[members[i].UserID] {
+			identity.UserID = ""
+			members[i].Identities = append(members[i].Identities, identity)
+		}
+	}
 	team.Members = members
-	return team, err
+
+	team.ReviewRules, err = getTeamRules(ctx, s.Pool, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return team, nil
 }
 
 // lockTeamByUserID блокирует команду пользователя через advisory lock и возвращает имя команды.
@@ -165,24 +311,29 @@ func (s *Store) lockTeamByName(ctx context.Context, tx pgx.Tx, teamName string)
 
 // SetUserActive изменяет флаг активности пользователя.
 // При деактивации автоматически переназначает ревьюеров для открытых PR.
-func (s *Store) SetUserActive(ctx context.Context, userID string, isActive bool) (*models.User, error) {
+// В режиме dryRun изменения откатываются, а план возвращается вызывающему.
+func (s *Store) SetUserActive(ctx context.Context, userID string, isActive, dryRun bool) (*models.User, *models.ChangePlan, error) {
+	ctx, span := startOp(ctx, "store.SetUserActive", attribute.String("user_id", userID), attribute.Bool("dry_run", dryRun))
+	defer span.End()
+
 	// Блокируем команду для предотвращения гонок данных
 	tx, err := s.Pool.Begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("begin tx: %w", err)
+		return nil, nil, fmt.Errorf("begin tx: %w", err)
 	}
 	defer func() {
 		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
-			log.Printf("Failed to rollback transaction: %v", err)
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
 		}
 	}()
-	_, err = s.lockTeamByUserID(ctx, tx, userID)
+	teamName, err := s.lockTeamByUserID(ctx, tx, userID)
 	if err != nil {
 		if err == ErrNotFound {
-			return nil, fmt.Errorf("set user active: %w", ErrNotFound)
+			return nil, nil, fmt.Errorf("set user active: %w", ErrNotFound)
 		}
-		return nil, err
+		return nil, nil, err
 	}
+	ctx = logging.With(ctx, "team", teamName)
 	// Если активируем пользователя, просто обновляем флаг
 	if isActive {
 		var user models.User
@@ -190,37 +341,57 @@ func (s *Store) SetUserActive(ctx context.Context, userID string, isActive bool)
 			UPDATE users
 			SET is_active = $1
 			WHERE user_id = $2
-			RETURNING user_id, username, team_name, is_active`,
-			isActive, userID).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive)
+			RETURNING user_id, username, team_name, is_active, role`,
+			isActive, userID).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Role)
 
 		if err != nil {
 			if err == pgx.ErrNoRows {
-				return nil, fmt.Errorf("set user active: %w", ErrNotFound)
+				return nil, nil, fmt.Errorf("set user active: %w", ErrNotFound)
 			}
-			return nil, fmt.Errorf("set user active: %w", err)
+			return nil, nil, fmt.Errorf("set user active: %w", err)
+		}
+		plan := models.NewChangePlan(dryRun, []string{user.UserID}, nil)
+		if dryRun {
+			return &user, plan, nil
 		}
-		if err := tx.Commit(ctx); err != nil {
-			return nil, fmt.Errorf("commit tx: %w", err)
+		if err := s.commitWithEvents(ctx, tx, []models.Event{userEvent(&user, models.ReasonManual)}); err != nil {
+			return nil, nil, err
 		}
-		return &user, nil
+		return &user, plan, nil
 	}
 
-	user, err := deactivateUserInTx(ctx, tx, userID)
+	user, reassignments, err := deactivateUserInTx(ctx, tx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("set user active: %w", err)
+		return nil, nil, fmt.Errorf("set user active: %w", err)
 	}
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("commit tx: %w", err)
+	plan := models.NewChangePlan(dryRun, []string{user.UserID}, reassignments)
+	if dryRun {
+		return user, plan, nil
 	}
-	return user, nil
+	events := append([]models.Event{userEvent(user, models.ReasonManual)},
+		replacedEvents(reassignments, user.TeamName, models.ReasonDeactivation)...)
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, nil, err
+	}
+	return user, plan, nil
 }
 
 // DeactivateTeamUsers выполняет массовую деактивацию участников команды.
 // Если список userIDs пуст, будут деактивированы все активные участники команды.
-func (s *Store) DeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string) ([]models.User, error) {
+// Каждый пользователь обрабатывается в отдельной точке сохранения:
+// при политике best_effort ошибки отдельных пользователей не мешают остальным,
+// при all_or_nothing любая ошибка откатывает всю операцию и возвращает ErrDeactivationFailed
+// вместе с отчётом. В режиме dryRun изменения откатываются всегда.
+func (s *Store) DeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string, policy string, dryRun bool) (*models.DeactivationReport, error) {
+	ctx, span := startOp(ctx, "store.DeactivateTeamUsers", attribute.String("team", teamName), attribute.Int("users", len(userIDs)), attribute.Bool("dry_run", dryRun))
+	defer span.End()
+
 	if teamName == "" {
 		return nil, fmt.Errorf("deactivate team users: team name is required")
 	}
+	if policy == "" {
+		policy = s.deactivationPolicy
+	}
 
 	tx, err := s.Pool.Begin(ctx)
 	if err != nil {
@@ -228,7 +399,7 @@ func (s *Store) DeactivateTeamUsers(ctx context.Context, teamName string, userID
 	}
 	defer func() {
 		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
-			log.Printf("Failed to rollback transaction: %v", err)
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
 		}
 	}()
 
@@ -237,62 +408,133 @@ func (s *Store) DeactivateTeamUsers(ctx context.Context, teamName string, userID
 		return nil, fmt.Errorf("deactivate team users: %w", err)
 	}
 
-	targetIDs, missing, err := s.selectTeamUserIDs(ctx, tx, teamName, userIDs)
+	userState, err := getTeamUserStatesInTx(ctx, tx, teamName)
 	if err != nil {
-		switch {
-		case errors.Is(err, ErrTeamNotFound):
-			return nil, fmt.Errorf("deactivate team users: %w", ErrTeamNotFound)
-		case errors.Is(err, ErrNotFound):
-			return nil, fmt.Errorf("deactivate team users: %w", ErrNotFound)
-		default:
-			return nil, fmt.Errorf("deactivate team users: %w", err)
+		return nil, fmt.Errorf("deactivate team users: %w", err)
+	}
+
+	requested := uniqueStrings(userIDs)
+	if len(userIDs) == 0 {
+		for id, active := range userState {
+			if active {
+				requested = append(requested, id)
+			}
 		}
+		sort.Strings(requested)
 	}
 
-	var (
-		deactivated []models.User
-		errs        []error
-	)
+	report := &models.DeactivationReport{
+		TeamName:     teamName,
+		Policy:       policy,
+		DryRun:       dryRun,
+		Users:        make([]models.UserDeactivationResult, 0, len(requested)),
+		PullRequests: []models.PullRequestOutcome{},
+	}
+
+	var moves []models.Reassignment
+	failed := false
+	for _, id := range requested {
+		active, ok := userState[id]
+		switch {
+		case !ok:
+			report.Users = append(report.Users, models.UserDeactivationResult{UserID: id, Status: models.DeactivationStatusMissing})
+			continue
+		case !active:
+			report.Users = append(report.Users, models.UserDeactivationResult{UserID: id, Status: models.DeactivationStatusAlreadyInactive})
+			continue
+		}
 
-	for _, id := range targetIDs {
-		//user, err := s.deactivateUserNoLock(ctx, id)
-		user, err := deactivateUserInTx(ctx, tx, id)
+		user, moved, err := deactivateUserInSavepoint(ctx, tx, id)
 		if err != nil {
-			errs = append(errs, fmt.Errorf("user %s: %w", id, err))
+			slog.WarnContext(ctx, "Failed to deactivate user", "user_id", id, "error", err)
+			failed = true
+			report.Users = append(report.Users, models.UserDeactivationResult{
+				UserID: id,
+				Status: models.DeactivationStatusFailed,
+				Error:  err.Error(),
+			})
+			var re *reassignError
+			if errors.As(err, &re) {
+				report.PullRequests = append(report.PullRequests, models.PullRequestOutcome{
+					PullRequestID: re.PullRequestID,
+					OldReviewerID: id,
+					Outcome:       models.OutcomeFailed,
+					Error:         re.Err.Error(),
+				})
+			}
 			continue
 		}
-		deactivated = append(deactivated, *user)
+
+		report.Users = append(report.Users, models.UserDeactivationResult{
+			UserID: id,
+			Status: models.DeactivationStatusDeactivated,
+			User:   user,
+		})
+		moves = append(moves, moved...)
+		for _, m := range moved {
+			outcome := models.PullRequestOutcome{
+				PullRequestID: m.PullRequestID,
+				OldReviewerID: m.OldReviewerID,
+				Outcome:       models.OutcomeReplaced,
+				ReplacedBy:    m.NewReviewerID,
+			}
+			if m.NewReviewerID == "" {
+				outcome.Outcome = models.OutcomeNoCandidate
+			}
+			report.PullRequests = append(report.PullRequests, outcome)
+		}
 	}
 
-	if len(deactivated) == 0 {
-		if len(errs) > 0 {
-			return nil, errors.Join(errs...)
+	if failed && policy == models.PolicyAllOrNothing {
+		return report, fmt.Errorf("deactivate team users: %w", ErrDeactivationFailed)
+	}
+	if dryRun {
+		return report, nil
+	}
+	var events []models.Event
+	for _, u := range report.Users {
+		if u.User != nil {
+			events = append(events, userEvent(u.User, models.ReasonManual))
 		}
-		return nil, fmt.Errorf("deactivate team users: %w", ErrNotFound)
 	}
-	//Логируем, если есть пользователи, которые не найдены в команде
-	//Но с найденными пользователями продолжаем работу
-	if len(missing) > 0 {
-		log.Printf("users not found in team %s: %s", teamName, strings.Join(missing, ", "))
+	events = append(events, replacedEvents(moves, teamName, models.ReasonDeactivation)...)
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, err
+	}
+	report.Committed = true
+	return report, nil
+}
+
+// deactivateUserInSavepoint деактивирует пользователя внутри точки сохранения,
+// чтобы ошибка не прерывала внешнюю транзакцию.
+func deactivateUserInSavepoint(ctx context.Context, tx pgx.Tx, userID string) (*models.User, []models.Reassignment, error) {
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("savepoint: %w", err)
 	}
 
-	if len(errs) > 0 {
-		return deactivated, errors.Join(errs...)
+	user, moved, err := deactivateUserInTx(ctx, sp, userID)
+	if err != nil {
+		if rbErr := sp.Rollback(ctx); rbErr != nil {
+			return nil, nil, errors.Join(err, fmt.Errorf("rollback savepoint: %w", rbErr))
+		}
+		return nil, nil, err
 	}
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("commit tx: %w", err)
+	if err := sp.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("release savepoint: %w", err)
 	}
-	return deactivated, nil
+	return user, moved, nil
 }
 
-func (s *Store) selectTeamUserIDs(ctx context.Context, tx pgx.Tx, teamName string, requested []string) ([]string, []string, error) {
+// getTeamUserStatesInTx возвращает флаги активности всех участников команды.
+func getTeamUserStatesInTx(ctx context.Context, tx pgx.Tx, teamName string) (map[string]bool, error) {
 	rows, err := tx.Query(ctx, `
 		SELECT user_id, is_active
 		FROM users
 		WHERE team_name = $1`,
 		teamName)
 	if err != nil {
-		return nil, nil, fmt.Errorf("fetch team users: %w", err)
+		return nil, fmt.Errorf("fetch team users: %w", err)
 	}
 	defer rows.Close()
 
@@ -302,71 +544,59 @@ func (s *Store) selectTeamUserIDs(ctx context.Context, tx pgx.Tx, teamName strin
 		var id string
 		var isActive bool
 		if err := rows.Scan(&id, &isActive); err != nil {
-			return nil, nil, fmt.Errorf("scan team user: %w", err)
+			return nil, fmt.Errorf("scan team user: %w", err)
 		}
 		userState[id] = isActive
 	}
 	if err := rows.Err(); err != nil {
-		return nil, nil, fmt.Errorf("iterate team users: %w", err)
+		return nil, fmt.Errorf("iterate team users: %w", err)
 	}
 
 	if len(userState) == 0 {
-		return nil, nil, fmt.Errorf("team %s not found: %w", teamName, ErrTeamNotFound)
-	}
-
-	if len(requested) == 0 {
-		var ids []string
-		for id, active := range userState {
-			if active {
-				ids = append(ids, id)
-			}
-		}
-		if len(ids) == 0 {
-			return nil, nil, fmt.Errorf("no active users in team %s: %w", teamName, ErrNotFound)
-		}
-		return ids, nil, nil
-	}
-
-	unique := uniqueStrings(requested)
-	if len(unique) == 0 {
-		return nil, nil, fmt.Errorf("no valid user ids provided: %w", ErrNotFound)
-	}
-
-	var ids []string
-	var missing []string
-	for _, id := range unique {
-		active, ok := userState[id]
-		if !ok {
-			missing = append(missing, id)
-			continue
-		}
-		if active {
-			ids = append(ids, id)
-		}
+		return nil, fmt.Errorf("team %s not found: %w", teamName, ErrTeamNotFound)
 	}
-
-	if len(ids) == 0 {
-		return nil, missing, fmt.Errorf("no active users matched selection: %w", ErrNotFound)
-	}
-
-	return ids, missing, nil
+	return userState, nil
 }
 
 // CreatePR создает новый PR в базе данных.
-func (s *Store) CreatePR(ctx context.Context, prID, prName, authorID string) (*models.PullRequest, error) {
+// В режиме dryRun PR не сохраняется, но возвращается с выбранными ревьюерами.
+func (s *Store) CreatePR(ctx context.Context, prID, prName, authorID string, dryRun bool) (*models.PullRequest, error) {
+	ctx, span := startOp(ctx, "store.CreatePR", attribute.String("pr_id", prID), attribute.Bool("dry_run", dryRun))
+	defer span.End()
+
 	tx, err := s.Pool.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("begin tx: %w", err)
 	}
 	defer func() {
 		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
-			log.Printf("Failed to rollback transaction: %v", err)
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
 		}
 	}()
+
+	pr, teamName, err := s.createPRInTx(ctx, tx, prID, prName, authorID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return pr, nil
+	}
+	events := append([]models.Event{prEvent(models.EventPRCreated, pr, teamName)}, assignedEvents(pr, teamName)...)
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// createPRInTx создает PR внутри транзакции и возвращает его вместе с командой автора.
+// Черновик создаётся без ревьюеров в статусе DRAFT,
+// остальные PR сразу получают ревьюеров из команды автора.
+func (s *Store) createPRInTx(ctx context.Context, tx pgx.Tx, prID, prName, authorID string, draft bool) (*models.PullRequest, string, error) {
 	// Блокируем команду автора для предотвращения гонок данных
 	authorTeam, err := s.lockTeamByUserID(ctx, tx, authorID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	var exists bool
@@ -374,78 +604,104 @@ func (s *Store) CreatePR(ctx context.Context, prID, prName, authorID string) (*m
         SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id = $1)`,
 		prID).Scan(&exists)
 	if err != nil {
-		return nil, fmt.Errorf("check PR exists: %w", err)
+		return nil, "", fmt.Errorf("check PR exists: %w", err)
 	}
 	if exists {
-		return nil, ErrPRExists
+		return nil, "", ErrPRExists
 	}
 
-	rows, err := tx.Query(ctx, `
-        SELECT user_id FROM users 
-        WHERE team_name = $1 
-        AND is_active = true 
-        AND user_id != $2`,
-		authorTeam, authorID)
-	if err != nil {
-		return nil, fmt.Errorf("get team members: %w", err)
-	}
-	defer rows.Close()
-
-	var candidates []string
-	for rows.Next() {
-		var userID string
-		if err := rows.Scan(&userID); err != nil {
-			return nil, fmt.Errorf("scan candidate: %w", err)
-		}
-		candidates = append(candidates, userID)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration: %w", err)
-	}
-
-	reviewers := selectReviewers(candidates)
-
 	pr := models.PullRequest{
 		PullRequestID:     prID,
 		PullRequestName:   prName,
 		AuthorID:          authorID,
-		Status:            "OPEN",
-		AssignedReviewers: reviewers,
+		Status:            "DRAFT",
+		AssignedReviewers: []string{},
 		CreatedAt:         time.Now(),
 	}
 
+	if !draft {
+		pr.Status = "OPEN"
+		pr.AssignedReviewers, pr.RuleViolations, err = s.pickReviewersInTx(ctx, tx, authorTeam, prID, authorID)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
 	_, err = tx.Exec(ctx, `
         INSERT INTO pull_requests 
         (pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at)
         VALUES ($1, $2, $3, $4, $5, $6)`,
 		pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, pr.AssignedReviewers, pr.CreatedAt)
 	if err != nil {
-		return nil, fmt.Errorf("insert PR: %w", err)
+		return nil, "", fmt.Errorf("insert PR: %w", err)
+	}
+
+	return &pr, authorTeam, nil
+}
+
+// pickReviewersInTx выбирает ревьюеров для PR из активных участников команды автора.
+func (s *Store) pickReviewersInTx(ctx context.Context, tx pgx.Tx, authorTeam, prID, authorID string) ([]string, []models.RuleViolation, error) {
+	rows, err := tx.Query(ctx, `
+        SELECT user_id, role FROM users 
+        WHERE team_name = $1 
+        AND is_active = true 
+        AND user_id != $2`,
+		authorTeam, authorID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get team members: %w", err)
+	}
+	candidates, err := scanCandidates(rows)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("commit tx: %w", err)
+	rules, err := getTeamRules(ctx, tx, authorTeam)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &pr, nil
+	reviewers, violations := selectReviewers(candidates, rules, s.reviewersPerPR)
+	if len(violations) > 0 {
+		slog.WarnContext(ctx, "Review rules not satisfied", "pr_id", prID, "team", authorTeam, "violations", violations)
+	}
+	return reviewers, violations, nil
 }
 
 // MergePR помечает PR как мерженный.
 func (s *Store) MergePR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	ctx, span := startOp(ctx, "store.MergePR", attribute.String("pr_id", prID))
+	defer span.End()
+
 	tx, err := s.Pool.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("begin tx: %w", err)
 	}
 	defer func() {
 		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
-			log.Printf("Failed to rollback transaction: %v", err)
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
 		}
 	}()
 
 	var pr models.PullRequest
 	var mergedAt *time.Time
+	var wasMerged bool
+	var teamName string
 
 	// Блокируем PR для предотвращения гонок данных
+	err = tx.QueryRow(ctx, `
+		SELECT p.status = 'MERGED', COALESCE(u.team_name, '')
+		FROM pull_requests p
+		LEFT JOIN users u ON u.user_id = p.author_id
+		WHERE p.pull_request_id = $1
+		FOR UPDATE OF p`,
+		prID).Scan(&wasMerged, &teamName)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("lock PR: %w", err)
+	}
+
 	err = tx.QueryRow(ctx, `
 		UPDATE pull_requests 
 		SET status = 'MERGED', merged_at = COALESCE(merged_at, CURRENT_TIMESTAMP)
@@ -463,108 +719,75 @@ func (s *Store) MergePR(ctx context.Context, prID string) (*models.PullRequest,
 
 	pr.MergedAt = mergedAt
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("commit tx: %w", err)
+	// Повторный мерж идемпотентен и не порождает событие
+	var events []models.Event
+	if !wasMerged {
+		events = append(events, prEvent(models.EventPRMerged, &pr, teamName))
+	}
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, err
 	}
 
 	return &pr, nil
 }
 
 // ReassignReviewer переназначает ревьювера на PR.
-func (s *Store) ReassignReviewer(ctx context.Context, prID, oldUserID string) (*models.PullRequest, string, error) {
+// В режиме dryRun замена подбирается, но не сохраняется.
+func (s *Store) ReassignReviewer(ctx context.Context, prID, oldUserID string, dryRun bool) (*models.PullRequest, string, error) {
+	ctx, span := startOp(ctx, "store.ReassignReviewer", attribute.String("pr_id", prID), attribute.String("user_id", oldUserID), attribute.Bool("dry_run", dryRun))
+	defer span.End()
+
 	tx, err := s.Pool.Begin(ctx)
 	if err != nil {
 		return nil, "", fmt.Errorf("begin tx: %w", err)
 	}
 	defer func() {
 		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
-			log.Printf("Failed to rollback transaction: %v", err)
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
 		}
 	}()
-	_, err = s.lockTeamByUserID(ctx, tx, oldUserID)
+	teamName, err := s.lockTeamByUserID(ctx, tx, oldUserID)
 	if err != nil {
 		return nil, "", fmt.Errorf("lock team: %w", err)
 	}
+	ctx = logging.With(ctx, "team", teamName)
 
-	var pr models.PullRequest
-	var mergedAt *time.Time
-	err = tx.QueryRow(ctx, `
-		SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at
-		FROM pull_requests WHERE pull_request_id = $1 FOR UPDATE`,
-		prID).Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status,
-		&pr.AssignedReviewers, &pr.CreatedAt, &mergedAt)
-
+	pr, newUserID, err := reassignReviewerInTx(ctx, tx, prID, oldUserID)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, "", ErrNotFound
-		}
-		return nil, "", fmt.Errorf("get PR: %w", err)
+		return nil, "", err
 	}
-
-	pr.MergedAt = mergedAt
-
-	if pr.Status == "MERGED" {
-		return nil, "", ErrPRMerged
-	}
-
-	if !contains(pr.AssignedReviewers, oldUserID) {
-		return nil, "", ErrNotAssigned
-	}
-
-	rows, err := tx.Query(ctx, `
-    SELECT u.user_id FROM users u
-    WHERE u.team_name = (SELECT team_name FROM users WHERE user_id = $1)
-    AND u.is_active = true
-    AND u.user_id != $1
-    AND u.user_id != (SELECT author_id FROM pull_requests WHERE pull_request_id = $2)
-    AND NOT EXISTS (
-        SELECT 1 FROM pull_requests pr 
-        WHERE pr.pull_request_id = $2 
-        AND pr.assigned_reviewers ? u.user_id
-    )`,
-		oldUserID, prID)
-	if err != nil {
-		return nil, "", fmt.Errorf("get candidates: %w", err)
-	}
-	defer rows.Close()
-
-	var candidates []string
-	for rows.Next() {
-		var userID string
-		if err := rows.Scan(&userID); err != nil {
-			return nil, "", fmt.Errorf("scan candidate: %w", err)
-		}
-		candidates = append(candidates, userID)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, "", fmt.Errorf("rows iteration: %w", err)
+	if dryRun {
+		return pr, newUserID, nil
 	}
 
-	if len(candidates) == 0 {
-		return nil, "", ErrNoCandidate
+	events := replacedEvents([]models.Reassignment{{
+		PullRequestID: prID,
+		OldReviewerID: oldUserID,
+		NewReviewerID: newUserID,
+	}}, teamName, models.ReasonManual)
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, "", err
 	}
 
-	newUserID := candidates[0]
-
-	newReviewers := replaceInSlice(pr.AssignedReviewers, oldUserID, newUserID)
+	return pr, newUserID, nil
+}
 
-	_, err = tx.Exec(ctx, `
-		UPDATE pull_requests 
-		SET assigned_reviewers = $1 
-		WHERE pull_request_id = $2`,
-		newReviewers, prID)
+// GetPRTeam возвращает команду PR — команду его автора.
+func (s *Store) GetPRTeam(ctx context.Context, prID string) (string, error) {
+	var teamName string
+	err := s.Pool.QueryRow(ctx, `
+		SELECT u.team_name
+		FROM pull_requests pr
+		JOIN users u ON u.user_id = pr.author_id
+		WHERE pr.pull_request_id = $1`,
+		prID).Scan(&teamName)
 	if err != nil {
-		return nil, "", fmt.Errorf("update reviewers: %w", err)
-	}
-
-	pr.AssignedReviewers = newReviewers
-
-	if err := tx.Commit(ctx); err != nil {
-		return nil, "", fmt.Errorf("commit: %w", err)
+		if err == pgx.ErrNoRows {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("get PR team: %w", err)
 	}
-
-	return &pr, newUserID, nil
+	return teamName, nil
 }
 
 // GetUserReviews возвращает PR, где пользователь назначен ревьювером.
@@ -596,25 +819,6 @@ func (s *Store) GetUserReviews(ctx context.Context, userID string) ([]models.Pul
 	return prs, nil
 }
 
-func selectReviewers(candidates []string) []string {
-	if len(candidates) == 0 {
-		return []string{}
-	}
-	if len(candidates) <= 2 {
-		return candidates
-	}
-
-	shuffled := make([]string, len(candidates))
-	copy(shuffled, candidates)
-
-	rand.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
-
-	count := minInt(2, len(shuffled))
-	return shuffled[:count]
-}
-
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
 		if s == item {
@@ -636,13 +840,6 @@ func replaceInSlice(slice []string, old, replacement string) []string {
 	return result
 }
 
-func minInt(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
 func uniqueStrings(values []string) []string {
 	result := make([]string, 0, len(values))
 	seen := make(map[string]struct{}, len(values))
@@ -730,3 +927,37 @@ func (s *Store) GetStats(ctx context.Context) (*models.Stats, error) {
 
 	return stats, nil
 }
+
+// OpenReviewsByTeam возвращает количество назначений на открытые PR по командам ревьюеров.
+func (s *Store) OpenReviewsByTeam(ctx context.Context) (map[string]int, error) {
+	rows, err := s.Pool.Query(ctx, `
+		SELECT u.team_name, COUNT(*)
+		FROM pull_requests p
+		CROSS JOIN LATERAL jsonb_array_elements_text(p.assigned_reviewers) AS r(reviewer)
+		JOIN users u ON u.user_id = r.reviewer
+		WHERE p.status = 'OPEN'
+		GROUP BY u.team_name`)
+	if err != nil {
+		return nil, fmt.Errorf("get open reviews by team: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var team string
+		var count int
+		if err := rows.Scan(&team, &count); err != nil {
+			return nil, fmt.Errorf("scan open reviews by team: %w", err)
+		}
+		counts[team] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+	return counts, nil
+}
+
+// PoolStat возвращает статистику пула соединений.
+func (s *Store) PoolStat() *pgxpool.Stat {
+	return s.Pool.Stat()
+}
@@ -0,0 +1,276 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SyncTeams приводит составы команд к описанию spec в одной транзакции.
+// Новые пользователи создаются, пользователи из других команд переносятся,
+// а участники команды, отсутствующие в описании, деактивируются.
+// Открытые ревью уходящих и деактивируемых участников переназначаются
+// внутри их прежней команды. В режиме dryRun возвращается план, а транзакция откатывается.
+func (s *Store) SyncTeams(ctx context.Context, spec models.TeamSyncSpec, dryRun bool) (*models.SyncPlan, error) {
+	ctx, span := startOp(ctx, "store.SyncTeams", attribute.Int("teams", len(spec.Teams)), attribute.Bool("dry_run", dryRun))
+	defer span.End()
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	teamNames := make([]string, 0, len(spec.Teams))
+	var userIDs []string
+	for _, t := range spec.Teams {
+		teamNames = append(teamNames, t.TeamName)
+		for _, m := range t.Members {
+			userIDs = append(userIDs, m.UserID)
+		}
+	}
+
+	// Блокируем описанные команды и команды, из которых переносятся пользователи,
+	// в порядке имён, чтобы параллельные синхронизации не взаимоблокировались.
+	rows, err := tx.Query(ctx, `SELECT DISTINCT team_name FROM users WHERE user_id = ANY($1)`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get origin teams: %w", err)
+	}
+	origins, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("get origin teams: %w", err)
+	}
+	locks := uniqueStrings(append(append([]string{}, teamNames...), origins...))
+	sort.Strings(locks)
+	for _, name := range locks {
+		if err := s.lockTeamByName(ctx, tx, name); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := getSyncUsersInTx(ctx, tx, teamNames, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &models.SyncPlan{
+		DryRun:        dryRun,
+		Changes:       []models.SyncChange{},
+		Reassignments: []models.Reassignment{},
+		NoCandidate:   []string{},
+	}
+	var events []models.Event
+	release := func(moves []models.Reassignment, teamName string) {
+		for _, m := range moves {
+			if m.NewReviewerID == "" {
+				plan.NoCandidate = append(plan.NoCandidate, m.PullRequestID)
+				continue
+			}
+			plan.Reassignments = append(plan.Reassignments, m)
+		}
+		events = append(events, replacedEvents(moves, teamName, models.ReasonSync)...)
+	}
+	record := func(changes ...models.SyncChange) {
+		plan.Changes = append(plan.Changes, changes...)
+		for _, c := range changes {
+			if c.Action == models.SyncActivateUser || c.Action == models.SyncDeactivateUser {
+				user := models.User{UserID: c.UserID, TeamName: c.TeamName, IsActive: c.Action == models.SyncActivateUser}
+				events = append(events, userEvent(&user, models.ReasonSync))
+			}
+		}
+	}
+
+	declared := make(map[string]bool, len(userIDs))
+	for _, t := range spec.Teams {
+		for _, m := range t.Members {
+			declared[m.UserID] = true
+			changes, moves, fromTeam, err := syncMemberInTx(ctx, tx, t.TeamName, m, current[m.UserID])
+			if err != nil {
+				return nil, err
+			}
+			record(changes...)
+			release(moves, fromTeam)
+		}
+	}
+
+	// Участники описанных команд, которых нет в описании, деактивируются
+	for _, id := range sortedUserIDs(current) {
+		u := current[id]
+		if declared[id] || !u.IsActive || !contains(teamNames, u.TeamName) {
+			continue
+		}
+		_, moves, err := deactivateUserInTx(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		record(models.SyncChange{
+			Action:   models.SyncDeactivateUser,
+			TeamName: u.TeamName,
+			UserID:   id,
+			Detail:   "not listed in team spec",
+		})
+		release(moves, u.TeamName)
+	}
+
+	for _, t := range spec.Teams {
+		if t.ReviewRules == nil {
+			continue
+		}
+		currentRules, err := getTeamRules(ctx, tx, t.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		if sameRules(currentRules, t.ReviewRules) {
+			continue
+		}
+		if err := replaceTeamRulesInTx(ctx, tx, t.TeamName, t.ReviewRules); err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, models.SyncChange{
+			Action:   models.SyncSetRules,
+			TeamName: t.TeamName,
+			Detail:   fmt.Sprintf("%d rules", len(t.ReviewRules)),
+		})
+	}
+
+	if dryRun {
+		return plan, nil
+	}
+	if err := s.commitWithEvents(ctx, tx, events); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// syncMemberInTx приводит одного пользователя к описанию m в команде teamName.
+// Возвращает изменения, переназначения его ревью и команду, в которой они выполнялись.
+func syncMemberInTx(ctx context.Context, tx pgx.Tx, teamName string, m models.MemberSpec, cur *models.User) ([]models.SyncChange, []models.Reassignment, string, error) {
+	role := m.Role
+	if role == "" {
+		role = models.RoleJunior
+	}
+	active := m.Active()
+
+	var changes []models.SyncChange
+	var moves []models.Reassignment
+	fromTeam := teamName
+
+	if cur == nil {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO users (user_id, username, team_name, is_active, role)
+			VALUES ($1, $2, $3, $4, $5)`,
+			m.UserID, m.Username, teamName, active, role)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("insert user: %w", err)
+		}
+		changes = append(changes, models.SyncChange{Action: models.SyncCreateUser, TeamName: teamName, UserID: m.UserID})
+	} else {
+		fromTeam = cur.TeamName
+		// Ревью отдаются до переноса: кандидаты подбираются из прежней команды
+		if cur.IsActive && (!active || cur.TeamName != teamName) {
+			var err error
+			moves, err = releaseReviewsInTx(ctx, tx, m.UserID)
+			if err != nil {
+				return nil, nil, "", err
+			}
+		}
+
+		_, err := tx.Exec(ctx, `
+			UPDATE users
+			SET username = $2, team_name = $3, is_active = $4, role = $5
+			WHERE user_id = $1`,
+			m.UserID, m.Username, teamName, active, role)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("update user: %w", err)
+		}
+
+		if cur.TeamName != teamName {
+			changes = append(changes, models.SyncChange{Action: models.SyncMoveUser, TeamName: teamName, UserID: m.UserID, FromTeam: cur.TeamName})
+		}
+		if cur.Username != m.Username || cur.Role != role {
+			changes = append(changes, models.SyncChange{
+				Action:   models.SyncUpdateUser,
+				TeamName: teamName,
+				UserID:   m.UserID,
+				Detail:   fmt.Sprintf("username=%s role=%s", m.Username, role),
+			})
+		}
+		if cur.IsActive != active {
+			action := models.SyncDeactivateUser
+			if active {
+				action = models.SyncActivateUser
+			}
+			changes = append(changes, models.SyncChange{Action: action, TeamName: teamName, UserID: m.UserID})
+		}
+	}
+
+	for _, identity := range m.Identities {
+		identity.UserID = m.UserID
+		if err := setIdentityInTx(ctx, tx, identity); err != nil {
+			return nil, nil, "", err
+		}
+	}
+	return changes, moves, fromTeam, nil
+}
+
+// getSyncUsersInTx возвращает пользователей описанных команд и перечисленных пользователей.
+func getSyncUsersInTx(ctx context.Context, tx pgx.Tx, teamNames, userIDs []string) (map[string]*models.User, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT user_id, username, team_name, is_active, role
+		FROM users
+		WHERE team_name = ANY($1) OR user_id = ANY($2)
+		FOR UPDATE`,
+		teamNames, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get sync users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make(map[string]*models.User)
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.UserID, &u.Username, &u.TeamName, &u.IsActive, &u.Role); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		users[u.UserID] = &u
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+	return users, nil
+}
+
+// sameRules сравнивает правила без учёта порядка.
+func sameRules(a, b []models.ReviewRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, r := range a {
+		counts[r.Role] = r.MinCount
+	}
+	for _, r := range b {
+		if c, ok := counts[r.Role]; !ok || c != r.MinCount {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedUserIDs(users map[string]*models.User) []string {
+	ids := make([]string, 0, len(users))
+	for id := range users {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/2Empty/review-assigner/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// tokenTouchInterval — не чаще этого интервала обновляется last_used_at,
+// чтобы каждый запрос не превращался в запись в базу.
+const tokenTouchInterval = time.Minute
+
+const tokenColumns = `id, name, scopes, created_at, expires_at, last_used_at, revoked_at`
+
+func scanToken(row pgx.Row) (*models.APIToken, error) {
+	var t models.APIToken
+	err := row.Scan(&t.ID, &t.Name, &t.Scopes, &t.CreatedAt, &t.ExpiresAt, &t.LastUsedAt, &t.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// CreateAPIToken сохраняет токен с хэшем его секрета и заполняет CreatedAt.
+func (s *Store) CreateAPIToken(ctx context.Context, token *models.APIToken, hash string) error {
+	err := s.Pool.QueryRow(ctx, `
+		INSERT INTO api_tokens (id, name, token_hash, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at`,
+		token.ID, token.Name, hash, token.Scopes, token.ExpiresAt).Scan(&token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("create API token: %w", err)
+	}
+	return nil
+}
+
+// GetAPITokenByHash находит токен по хэшу секрета, включая отозванные и истёкшие.
+func (s *Store) GetAPITokenByHash(ctx context.Context, hash string) (*models.APIToken, error) {
+	t, err := scanToken(s.Pool.QueryRow(ctx, `
+		SELECT `+tokenColumns+` FROM api_tokens WHERE token_hash = $1`, hash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get API token: %w", err)
+	}
+	return t, nil
+}
+
+// TouchAPIToken отмечает использование токена.
+func (s *Store) TouchAPIToken(ctx context.Context, id string) error {
+	_, err := s.Pool.Exec(ctx, `
+		UPDATE api_tokens SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - make_interval(secs => $2))`,
+		id, tokenTouchInterval.Seconds())
+	if err != nil {
+		return fmt.Errorf("touch API token: %w", err)
+	}
+	return nil
+}
+
+// ListAPITokens возвращает все токены, начиная с новых.
+func (s *Store) ListAPITokens(ctx context.Context) ([]models.APIToken, error) {
+	rows, err := s.Pool.Query(ctx, `
+		SELECT `+tokenColumns+` FROM api_tokens ORDER BY created_at DESC, id`)
+	if err != nil {
+		return nil, fmt.Errorf("list API tokens: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := []models.APIToken{}
+	for rows.Next() {
+		t, err := scanToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan API token: %w", err)
+		}
+		tokens = append(tokens, *t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+	return tokens, nil
+}
+
+// RevokeAPIToken отзывает токен. Повторный отзыв не меняет время отзыва.
+func (s *Store) RevokeAPIToken(ctx context.Context, id string) (*models.APIToken, error) {
+	t, err := scanToken(s.Pool.QueryRow(ctx, `
+		UPDATE api_tokens SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE id = $1
+		RETURNING `+tokenColumns, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("revoke API token: %w", err)
+	}
+	return t, nil
+}
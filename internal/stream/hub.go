@@ -0,0 +1,103 @@
+// Package stream раздаёт события store подписчикам потоковых соединений (SSE).
+package stream
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/2Empty/review-assigner/internal/models"
+)
+
+// defaultBuffer — размер буфера подписки по умолчанию.
+const defaultBuffer = 64
+
+// Hub реализует store.Listener и рассылает события подписчикам.
+// Подписчик, не успевающий читать события, отключается: клиент
+// переподключается с Last-Event-ID и дочитывает пропущенное из журнала.
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+// Subscription — подписка на события, подходящие под фильтр.
+type Subscription struct {
+	filter models.EventFilter
+	events chan models.Event
+}
+
+// Events возвращает канал событий; он закрывается при отписке или переполнении.
+func (s *Subscription) Events() <-chan models.Event {
+	return s.events
+}
+
+// NewHub создает пустой Hub.
+func NewHub() *Hub {
+	return &Hub{subs: make(map[*Subscription]struct{})}
+}
+
+// Subscribe регистрирует подписку. buffer <= 0 означает размер по умолчанию.
+func (h *Hub) Subscribe(filter models.EventFilter, buffer int) *Subscription {
+	if buffer <= 0 {
+		buffer = defaultBuffer
+	}
+	sub := &Subscription{filter: filter, events: make(chan models.Event, buffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(sub.events)
+		return sub
+	}
+	h.subs[sub] = struct{}{}
+	return sub
+}
+
+// Close отключает всех подписчиков; подписки, оформленные позже, сразу закрыты.
+// Используется при остановке сервера, чтобы потоковые соединения не задерживали её.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for sub := range h.subs {
+		h.remove(sub)
+	}
+}
+
+// Unsubscribe удаляет подписку и закрывает её канал. Повторный вызов безопасен.
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub)
+}
+
+// HandleEvents передаёт события подписчикам без блокировки.
+func (h *Hub) HandleEvents(events []models.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		for _, e := range events {
+			if !sub.filter.Match(e) {
+				continue
+			}
+			select {
+			case sub.events <- e:
+			default:
+				slog.Warn("Event stream subscriber is too slow, disconnecting")
+				h.remove(sub)
+			}
+			if _, ok := h.subs[sub]; !ok {
+				break
+			}
+		}
+	}
+}
+
+func (h *Hub) remove(sub *Subscription) {
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	close(sub.events)
+}
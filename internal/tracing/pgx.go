@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	stringLiteral  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	numericLiteral = regexp.MustCompile(`(^|[^\w$.])\d+(?:\.\d+)?`)
+	whitespace     = regexp.MustCompile(`\s+`)
+)
+
+// SanitizeSQL заменяет строковые и числовые литералы на ? и схлопывает пробелы.
+// Параметры $N остаются как есть: их значения в спан не попадают.
+func SanitizeSQL(sql string) string {
+	sql = stringLiteral.ReplaceAllString(sql, "?")
+	sql = numericLiteral.ReplaceAllString(sql, "${1}?")
+	return strings.TrimSpace(whitespace.ReplaceAllString(sql, " "))
+}
+
+// operation возвращает первое ключевое слово запроса: SELECT, UPDATE, BEGIN...
+func operation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToUpper(fields[0])
+}
+
+// QueryTracer реализует pgx.QueryTracer и создаёт клиентский спан на каждый
+// запрос к базе, включая BEGIN и COMMIT транзакций.
+type QueryTracer struct{}
+
+// TraceQueryStart начинает спан запроса.
+func (QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	op := operation(data.SQL)
+	ctx, _ = otel.Tracer(instrumentationName).Start(ctx, "db "+op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(op),
+			semconv.DBQueryText(SanitizeSQL(data.SQL)),
+		),
+	)
+	return ctx
+}
+
+// TraceQueryEnd завершает спан запроса и записывает ошибку.
+func (QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int64("db.response.rows_affected", data.CommandTag.RowsAffected()))
+}
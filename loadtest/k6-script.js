@@ -25,6 +25,12 @@ export const options = {
 
 const BASE_URL = "http://localhost:8080";
 
+// Токен с областью admin; в docker-compose это AUTH_BOOTSTRAP_TOKEN
+const HEADERS = {
+  "Content-Type": "application/json",
+  "Authorization": `Bearer ${__ENV.AUTH_TOKEN}`,
+};
+
 export default function () {
   const teamName = "team-" + uuidv4().substring(0, 6);
   const user1 = "u-" + uuidv4().substring(0, 6);
@@ -42,7 +48,7 @@ export default function () {
       { user_id: user3, username: "User3", is_active: true },
       { user_id: user4, username: "User4", is_active: true },
     ]
-  }), { headers: { "Content-Type": "application/json" }});
+  }), { headers: HEADERS });
 
   check(res, { "team created": (r) => r.status === 201 || r.status === 400 });
 
@@ -51,7 +57,7 @@ export default function () {
     pull_request_id: prId,
     pull_request_name: "Test PR",
     author_id: user1,
-  }), { headers: { "Content-Type": "application/json" }});
+  }), { headers: HEADERS });
 
   check(res, { "pr created or exists": (r) => r.status === 201 || r.status === 409 });
 
@@ -65,13 +71,13 @@ export default function () {
   }
 
   // 3. Получить список PR пользователя-ревьювера
-  res = http.get(`${BASE_URL}/users/getReview?user_id=${user2}`);
+  res = http.get(`${BASE_URL}/users/getReview?user_id=${user2}`, { headers: HEADERS });
   check(res, { "get review ok": (r) => r.status === 200 || r.status === 404 });
 
   // 4. Попробовать переназначить ревьювера
   res = http.post(`${BASE_URL}/pullRequest/reassign`,
     JSON.stringify({ pull_request_id: prId, old_user_id: assignedReviewer }),
-    { headers: { "Content-Type": "application/json" }}
+    { headers: HEADERS }
   );
 
   check(res, { "reassign allowed or domain error": (r) => [200, 409, 404].includes(r.status) });
@@ -79,7 +85,7 @@ export default function () {
   // 5. Пометить как merge (идемпотентно)
   res = http.post(`${BASE_URL}/pullRequest/merge`, JSON.stringify({
     pull_request_id: prId
-  }), { headers: { "Content-Type": "application/json" }});
+  }), { headers: HEADERS });
 
   check(res, { "merged or not found": (r) => r.status === 200 || r.status === 404 });
 
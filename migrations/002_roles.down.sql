@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS team_review_rules;
+ALTER TABLE IF EXISTS users DROP COLUMN IF EXISTS role;
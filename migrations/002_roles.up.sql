@@ -0,0 +1,9 @@
+ALTER TABLE users
+    ADD COLUMN role TEXT NOT NULL DEFAULT 'junior' CHECK (role IN ('junior', 'senior', 'lead'));
+
+CREATE TABLE team_review_rules (
+    team_name TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('junior', 'senior', 'lead')),
+    min_count INTEGER NOT NULL CHECK (min_count > 0),
+    PRIMARY KEY (team_name, role)
+);
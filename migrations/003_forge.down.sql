@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS pr_external_refs;
+ALTER TABLE IF EXISTS pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+ALTER TABLE IF EXISTS pull_requests
+    ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('OPEN', 'MERGED'));
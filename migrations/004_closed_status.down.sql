@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+ALTER TABLE IF EXISTS pull_requests
+    ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('DRAFT', 'OPEN', 'MERGED'));
@@ -0,0 +1,8 @@
+CREATE TABLE user_identities (
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    provider TEXT NOT NULL CHECK (provider IN ('github', 'gitlab', 'slack', 'email')),
+    external_id TEXT NOT NULL,
+    PRIMARY KEY (user_id, provider)
+);
+
+CREATE UNIQUE INDEX idx_user_identities_external ON user_identities(provider, lower(external_id));
@@ -0,0 +1,7 @@
+CREATE TABLE schema_migrations (
+    version INTEGER PRIMARY KEY,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Каждая следующая миграция добавляет сюда свою версию
+INSERT INTO schema_migrations (version) VALUES (1), (2), (3), (4), (5), (6), (7);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS api_tokens;
+
+DELETE FROM schema_migrations WHERE version = 8;
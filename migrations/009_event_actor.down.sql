@@ -0,0 +1,3 @@
+ALTER TABLE events DROP COLUMN IF EXISTS actor;
+
+DELETE FROM schema_migrations WHERE version = 9;
@@ -0,0 +1,4 @@
+-- Инициатор изменения: пользователь из JWT или API-токен
+ALTER TABLE events ADD COLUMN actor TEXT NOT NULL DEFAULT '';
+
+INSERT INTO schema_migrations (version) VALUES (9);